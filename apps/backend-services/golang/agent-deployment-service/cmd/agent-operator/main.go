@@ -0,0 +1,75 @@
+// Command agent-operator runs the CRD-driven Agent controller described in
+// docs/alt/main.go as a standalone binary, alongside the queue-based
+// agent-deployment-service.
+package main
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/adapters/container"
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/operator"
+	clientset "github.com/thomas-caarter-aic/agent-deployment-service/pkg/generated/clientset/versioned"
+)
+
+func main() {
+	var kubeconfig *string
+	if home := homeDir(); home != "" {
+		kubeconfig = flag.String("kubeconfig", filepath.Join(home, ".kube", "config"), "(optional) absolute path to the kubeconfig file")
+	} else {
+		kubeconfig = flag.String("kubeconfig", "", "absolute path to the kubeconfig file")
+	}
+	namespace := flag.String("namespace", metav1.NamespaceDefault, "namespace to watch for Agent resources")
+	flag.Parse()
+
+	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	if err != nil {
+		klog.Fatalf("Error building kubeconfig: %v", err)
+	}
+
+	agentClient, err := clientset.NewForConfig(config)
+	if err != nil {
+		klog.Fatalf("Error building agent clientset: %v", err)
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		klog.Fatalf("Error building kube clientset: %v", err)
+	}
+
+	containerAdapter, err := container.NewKubernetesAdapter(config, *namespace)
+	if err != nil {
+		klog.Fatalf("Error building container adapter: %v", err)
+	}
+
+	reconciler := operator.NewK8sAdapter(kubeClient, containerAdapter, *namespace)
+	controller := operator.NewController(agentClient, kubeClient, reconciler, *namespace, 5*time.Minute)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		close(stopCh)
+	}()
+
+	controller.Run(3, stopCh)
+}
+
+func homeDir() string {
+	if h := os.Getenv("HOME"); h != "" {
+		return h
+	}
+	return os.Getenv("USERPROFILE")
+}
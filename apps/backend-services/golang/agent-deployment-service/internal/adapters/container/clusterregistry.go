@@ -0,0 +1,174 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// MemberCluster is a single cluster known to a ClusterRegistry, with a
+// pooled clientset ready to use against it.
+type MemberCluster struct {
+	Name      string
+	Labels    map[string]string
+	Clientset kubernetes.Interface
+}
+
+// ClusterRegistry resolves the set of member clusters a PropagationPolicy
+// can target.
+type ClusterRegistry interface {
+	Clusters(ctx context.Context) ([]MemberCluster, error)
+}
+
+// clientsetPool lazily builds and caches one kubernetes.Clientset per
+// cluster name so repeated reconciles don't rebuild REST clients.
+type clientsetPool struct {
+	mu    sync.Mutex
+	byKey map[string]kubernetes.Interface
+}
+
+func newClientsetPool() *clientsetPool {
+	return &clientsetPool{byKey: make(map[string]kubernetes.Interface)}
+}
+
+func (p *clientsetPool) get(name string, kubeconfig []byte) (kubernetes.Interface, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if cs, ok := p.byKey[name]; ok {
+		return cs, nil
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("parse kubeconfig for cluster %s: %w", name, err)
+	}
+	cs, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("build clientset for cluster %s: %w", name, err)
+	}
+	p.byKey[name] = cs
+	return cs, nil
+}
+
+// FileClusterRegistry discovers member clusters from kubeconfig files in a
+// directory, one file per cluster named "<cluster>.kubeconfig". Labels are
+// taken from a "<cluster>.labels" sidecar file of "key=value" lines, if
+// present.
+type FileClusterRegistry struct {
+	Dir  string
+	pool *clientsetPool
+}
+
+// NewFileClusterRegistry builds a ClusterRegistry backed by kubeconfig
+// files under dir.
+func NewFileClusterRegistry(dir string) *FileClusterRegistry {
+	return &FileClusterRegistry{Dir: dir, pool: newClientsetPool()}
+}
+
+func (r *FileClusterRegistry) Clusters(_ context.Context) ([]MemberCluster, error) {
+	entries, err := os.ReadDir(r.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("read cluster registry dir %s: %w", r.Dir, err)
+	}
+
+	var clusters []MemberCluster
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".kubeconfig") {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".kubeconfig")
+
+		kubeconfig, err := os.ReadFile(filepath.Join(r.Dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read kubeconfig for cluster %s: %w", name, err)
+		}
+		cs, err := r.pool.get(name, kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+
+		clusters = append(clusters, MemberCluster{
+			Name:      name,
+			Labels:    readLabelsFile(filepath.Join(r.Dir, name+".labels")),
+			Clientset: cs,
+		})
+	}
+	return clusters, nil
+}
+
+func readLabelsFile(path string) map[string]string {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	labels := make(map[string]string)
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		labels[key] = value
+	}
+	return labels
+}
+
+// SecretClusterRegistry discovers member clusters from Secrets in the
+// management cluster: each Secret's "kubeconfig" data key holds the member
+// cluster's kubeconfig, and the Secret's own labels are used for
+// PropagationPolicy cluster selection.
+type SecretClusterRegistry struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	pool       *clientsetPool
+}
+
+// NewSecretClusterRegistry builds a ClusterRegistry backed by Secrets in
+// namespace on the management cluster reachable through kubeClient.
+func NewSecretClusterRegistry(kubeClient kubernetes.Interface, namespace string) *SecretClusterRegistry {
+	return &SecretClusterRegistry{kubeClient: kubeClient, namespace: namespace, pool: newClientsetPool()}
+}
+
+func (r *SecretClusterRegistry) Clusters(ctx context.Context) ([]MemberCluster, error) {
+	secrets, err := r.kubeClient.CoreV1().Secrets(r.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "agentdeployment.ai.example.com/cluster-registry=true",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster registry secrets: %w", err)
+	}
+
+	var clusters []MemberCluster
+	for _, secret := range secrets.Items {
+		kubeconfig, ok := secretKubeconfig(secret)
+		if !ok {
+			continue
+		}
+		cs, err := r.pool.get(secret.Name, kubeconfig)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, MemberCluster{
+			Name:      secret.Name,
+			Labels:    secret.Labels,
+			Clientset: cs,
+		})
+	}
+	return clusters, nil
+}
+
+func secretKubeconfig(secret corev1.Secret) ([]byte, bool) {
+	kubeconfig, ok := secret.Data["kubeconfig"]
+	return kubeconfig, ok
+}
@@ -0,0 +1,100 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	dockertypes "github.com/docker/docker/api/types"
+	dockercontainer "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// DockerAdapter runs an agent as a plain Docker/containerd container,
+// patterned after the gitlab-runner Kubernetes executor's pull/create/start
+// sequence but targeting a local container runtime instead of a cluster.
+type DockerAdapter struct {
+	client *client.Client
+}
+
+// NewDockerAdapter connects to the Docker/containerd socket using the
+// ambient DOCKER_HOST environment, the same convention the Docker CLI uses.
+func NewDockerAdapter() (*DockerAdapter, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("connect to container runtime: %w", err)
+	}
+	return &DockerAdapter{client: cli}, nil
+}
+
+func (d *DockerAdapter) DeployAgent(ctx context.Context, task model.DeploymentTask) error {
+	if err := d.pullImage(ctx, task); err != nil {
+		return err
+	}
+
+	resp, err := d.client.ContainerCreate(ctx,
+		&dockercontainer.Config{
+			Image:  task.ContainerImg,
+			Labels: map[string]string{"tenant": task.TenantID, "agent": task.AgentID},
+		},
+		&dockercontainer.HostConfig{
+			Resources: dockerResources(task.Resources),
+			DNS:       task.DNSNameservers,
+		},
+		nil, nil, task.AgentID,
+	)
+	if err != nil {
+		return fmt.Errorf("create container %s: %w", task.AgentID, err)
+	}
+
+	if err := d.client.ContainerStart(ctx, resp.ID, dockercontainer.StartOptions{}); err != nil {
+		return fmt.Errorf("start container %s: %w", task.AgentID, err)
+	}
+	return nil
+}
+
+// UpdateAgent replaces the container, since Docker containers can't have
+// their image swapped in place.
+func (d *DockerAdapter) UpdateAgent(ctx context.Context, task model.DeploymentTask) error {
+	if err := d.DeleteAgent(ctx, task); err != nil {
+		return err
+	}
+	return d.DeployAgent(ctx, task)
+}
+
+func (d *DockerAdapter) DeleteAgent(ctx context.Context, task model.DeploymentTask) error {
+	err := d.client.ContainerRemove(ctx, task.AgentID, dockercontainer.RemoveOptions{Force: true})
+	if client.IsErrNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (d *DockerAdapter) pullImage(ctx context.Context, task model.DeploymentTask) error {
+	if task.PullPolicy == model.PullNever {
+		return nil
+	}
+
+	reader, err := d.client.ImagePull(ctx, task.ContainerImg, dockertypes.ImagePullOptions{})
+	if err != nil {
+		return fmt.Errorf("pull image %s: %w", task.ContainerImg, err)
+	}
+	defer reader.Close()
+	_, err = io.Copy(io.Discard, reader)
+	return err
+}
+
+func dockerResources(r model.ResourceRequirements) dockercontainer.Resources {
+	var resources dockercontainer.Resources
+	if mem, ok := r.Limits["memory"]; ok {
+		if q, err := resource.ParseQuantity(mem); err == nil {
+			if bytes, ok := q.AsInt64(); ok {
+				resources.Memory = bytes
+			}
+		}
+	}
+	return resources
+}
@@ -0,0 +1,113 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// HelmAdapter implements Adapter by installing, upgrading or uninstalling a
+// Helm release for each DeploymentTask, rather than talking to the
+// Kubernetes API directly. The Orchestrator dispatches to it for the
+// model.ActionInstallChart / ActionUpgradeChart / ActionUninstallChart
+// actions.
+type HelmAdapter struct {
+	namespace string
+	cfg       *action.Configuration
+}
+
+// NewHelmAdapter builds a HelmAdapter configured against namespace using
+// the ambient kubeconfig/in-cluster config that Helm's CLI settings
+// resolve, unlike NewKubernetesAdapter, which takes an already-resolved
+// config from its caller.
+func NewHelmAdapter(namespace string) (*HelmAdapter, error) {
+	settings := cli.New()
+	settings.SetNamespace(namespace)
+
+	cfg := new(action.Configuration)
+	// Route Helm's internal log callback through the standard logger
+	// instead of discarding it, so hook lifecycle lines ("waiting for
+	// hook: ...", "deleting pre-upgrade hook ...") show up in our logs
+	// instead of vanishing, which is where a stuck or failing hook would
+	// otherwise go unnoticed.
+	logf := func(format string, args ...interface{}) {
+		log.Printf("helm["+namespace+"]: "+format, args...)
+	}
+	if err := cfg.Init(settings.RESTClientGetter(), namespace, "secret", logf); err != nil {
+		return nil, fmt.Errorf("init helm configuration: %w", err)
+	}
+	return &HelmAdapter{namespace: namespace, cfg: cfg}, nil
+}
+
+func (h *HelmAdapter) DeployAgent(ctx context.Context, task model.DeploymentTask) error {
+	chrt, err := loader.Load(task.ChartRef)
+	if err != nil {
+		return fmt.Errorf("load chart %s for agent %s: %w", task.ChartRef, task.AgentID, err)
+	}
+
+	client := action.NewInstall(h.cfg)
+	client.Namespace = h.namespace
+	client.ReleaseName = releaseName(task)
+	client.Timeout = task.Timeout
+	client.Wait = task.Timeout > 0
+	// DisableHooks defaults to false: Helm runs the chart's pre-install
+	// and post-install hooks in their declared weight order on its own,
+	// this flag only lets us turn hooks off entirely if we ever need to.
+
+	if _, err := client.RunWithContext(ctx, chrt, task.Values); err != nil {
+		return wrapHookErr("install", task, err)
+	}
+	return nil
+}
+
+func (h *HelmAdapter) UpdateAgent(ctx context.Context, task model.DeploymentTask) error {
+	chrt, err := loader.Load(task.ChartRef)
+	if err != nil {
+		return fmt.Errorf("load chart %s for agent %s: %w", task.ChartRef, task.AgentID, err)
+	}
+
+	client := action.NewUpgrade(h.cfg)
+	client.Namespace = h.namespace
+	client.Timeout = task.Timeout
+	client.Wait = task.Timeout > 0
+
+	if _, err := client.RunWithContext(ctx, releaseName(task), chrt, task.Values); err != nil {
+		return wrapHookErr("upgrade", task, err)
+	}
+	return nil
+}
+
+// wrapHookErr labels a Helm action error as a hook-stage failure when
+// Helm's own error text names one, so callers can tell "the chart never
+// applied" apart from "the chart applied but a pre/post hook failed"
+// without parsing Helm's error strings themselves.
+func wrapHookErr(action string, task model.DeploymentTask, err error) error {
+	if strings.Contains(strings.ToLower(err.Error()), "hook") {
+		return fmt.Errorf("%s chart %s for agent %s: hook failed: %w", action, task.ChartRef, task.AgentID, err)
+	}
+	return fmt.Errorf("%s chart %s for agent %s: %w", action, task.ChartRef, task.AgentID, err)
+}
+
+func (h *HelmAdapter) DeleteAgent(ctx context.Context, task model.DeploymentTask) error {
+	client := action.NewUninstall(h.cfg)
+	client.Timeout = task.Timeout
+
+	if _, err := client.Run(releaseName(task)); err != nil {
+		return fmt.Errorf("uninstall release %s for agent %s: %w", releaseName(task), task.AgentID, err)
+	}
+	return nil
+}
+
+func releaseName(task model.DeploymentTask) string {
+	if task.ReleaseName != "" {
+		return task.ReleaseName
+	}
+	return task.AgentID
+}
@@ -0,0 +1,23 @@
+package container
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+func TestWrapHookErr_LabelsHookFailures(t *testing.T) {
+	task := model.DeploymentTask{ChartRef: "./charts/agent", AgentID: "agent-test"}
+
+	err := wrapHookErr("install", task, errors.New(`warning: Hook pre-install agent-test/templates/hooks/init-job.yaml failed`))
+	if !strings.Contains(err.Error(), "hook failed") {
+		t.Fatalf("expected hook failure to be labeled, got: %v", err)
+	}
+
+	err = wrapHookErr("install", task, errors.New("connection refused"))
+	if strings.Contains(err.Error(), "hook failed") {
+		t.Fatalf("non-hook error should not be mislabeled as a hook failure, got: %v", err)
+	}
+}
@@ -26,13 +26,11 @@ type KubernetesAdapter struct {
 	namespace string
 }
 
-// NewKubernetesAdapter initializes Kubernetes client
-func NewKubernetesAdapter(namespace string) (*KubernetesAdapter, error) {
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		return nil, err
-	}
-
+// NewKubernetesAdapter builds a KubernetesAdapter from an already-resolved
+// config, so callers control how it's built (in-cluster, --kubeconfig, or
+// otherwise) instead of the adapter silently assuming it's running
+// in-cluster.
+func NewKubernetesAdapter(config *rest.Config, namespace string) (*KubernetesAdapter, error) {
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return nil, err
@@ -47,6 +45,11 @@ func NewKubernetesAdapter(namespace string) (*KubernetesAdapter, error) {
 func (k *KubernetesAdapter) DeployAgent(ctx context.Context, task model.DeploymentTask) error {
 	deployments := k.clientset.AppsV1().Deployments(k.namespace)
 
+	replicas := task.Replicas
+	if replicas == 0 {
+		replicas = 1
+	}
+
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: task.AgentID,
@@ -56,7 +59,7 @@ func (k *KubernetesAdapter) DeployAgent(ctx context.Context, task model.Deployme
 			},
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: int32Ptr(1),
+			Replicas: int32Ptr(replicas),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
 					"agent": task.AgentID,
@@ -68,14 +71,7 @@ func (k *KubernetesAdapter) DeployAgent(ctx context.Context, task model.Deployme
 						"agent": task.AgentID,
 					},
 				},
-				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name:  task.AgentID,
-							Image: task.ContainerImg,
-						},
-					},
-				},
+				Spec: PodSpecBuilder{}.Build(task),
 			},
 		},
 	}
@@ -0,0 +1,256 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	agentdeploymentv1 "github.com/thomas-caarter-aic/agent-deployment-service/pkg/apis/agentdeployment/v1"
+	clientset "github.com/thomas-caarter-aic/agent-deployment-service/pkg/generated/clientset/versioned"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// StatusReporter publishes the aggregated outcome of a multi-cluster
+// rollout back onto the parent Agent CR.
+type StatusReporter interface {
+	ReportResourceBinding(ctx context.Context, agentID string, binding agentdeploymentv1.ResourceBindingStatus) error
+}
+
+// AgentStatusReporter is the StatusReporter backed by the Agent CRD's
+// generated clientset.
+type AgentStatusReporter struct {
+	client    clientset.Interface
+	namespace string
+}
+
+// NewAgentStatusReporter builds a StatusReporter that writes to Agent CRs
+// in namespace through client.
+func NewAgentStatusReporter(client clientset.Interface, namespace string) *AgentStatusReporter {
+	return &AgentStatusReporter{client: client, namespace: namespace}
+}
+
+func (r *AgentStatusReporter) ReportResourceBinding(ctx context.Context, agentID string, binding agentdeploymentv1.ResourceBindingStatus) error {
+	agent, err := r.client.AgentdeploymentV1().Agents(r.namespace).Get(ctx, agentID, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get agent %s to report resource binding: %w", agentID, err)
+	}
+	agent = agent.DeepCopy()
+	agent.Status.ResourceBinding = &binding
+	_, err = r.client.AgentdeploymentV1().Agents(r.namespace).UpdateStatus(ctx, agent, metav1.UpdateOptions{})
+	return err
+}
+
+// PolicyResolver picks the PropagationPolicy a DeploymentTask should be
+// scheduled under. Most callers resolve this from the task's TenantID or
+// AgentID against a policy store; a function keeps MultiClusterAdapter
+// agnostic of how that store is shaped.
+type PolicyResolver func(task model.DeploymentTask) PropagationPolicy
+
+// MultiClusterAdapter implements Adapter by fanning a DeploymentTask out to
+// every member cluster its PropagationPolicy selects, mirroring karmada's
+// PropagationPolicy/Work/ResourceBinding split.
+type MultiClusterAdapter struct {
+	registry      ClusterRegistry
+	resolvePolicy PolicyResolver
+	reporter      StatusReporter
+	namespace     string
+}
+
+// NewMultiClusterAdapter builds a MultiClusterAdapter. reporter may be nil
+// if the caller doesn't need aggregated status written back to an Agent CR.
+func NewMultiClusterAdapter(registry ClusterRegistry, resolvePolicy PolicyResolver, reporter StatusReporter, namespace string) *MultiClusterAdapter {
+	return &MultiClusterAdapter{
+		registry:      registry,
+		resolvePolicy: resolvePolicy,
+		reporter:      reporter,
+		namespace:     namespace,
+	}
+}
+
+func (m *MultiClusterAdapter) DeployAgent(ctx context.Context, task model.DeploymentTask) error {
+	return m.propagate(ctx, task)
+}
+
+func (m *MultiClusterAdapter) UpdateAgent(ctx context.Context, task model.DeploymentTask) error {
+	return m.propagate(ctx, task)
+}
+
+func (m *MultiClusterAdapter) DeleteAgent(ctx context.Context, task model.DeploymentTask) error {
+	clusters, err := m.registry.Clusters(ctx)
+	if err != nil {
+		return err
+	}
+	policy := m.resolvePolicy(task)
+	targets := selectClusters(clusters, policy.ClusterSelector)
+
+	var errs []error
+	for _, target := range targets {
+		err := target.Clientset.AppsV1().Deployments(m.namespace).Delete(ctx, task.AgentID, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete on cluster %s: %w", target.Name, err))
+		}
+	}
+	return combineErrors(errs)
+}
+
+// propagate renders a Work per targeted cluster and applies it, then
+// reports the aggregated outcome through the StatusReporter.
+func (m *MultiClusterAdapter) propagate(ctx context.Context, task model.DeploymentTask) error {
+	clusters, err := m.registry.Clusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	policy := m.resolvePolicy(task)
+	targets := selectClusters(clusters, policy.ClusterSelector)
+	if len(targets) == 0 {
+		return fmt.Errorf("no member clusters matched the propagation policy for agent %s", task.AgentID)
+	}
+
+	works := renderWorks(task, policy, targets)
+
+	statuses := make([]agentdeploymentv1.ClusterStatus, 0, len(works))
+	var errs []error
+	for _, work := range works {
+		clusterStatus := agentdeploymentv1.ClusterStatus{ClusterName: work.ClusterName}
+
+		target := targets[work.ClusterName]
+		if err := applyWork(ctx, target.Clientset, m.namespace, work); err != nil {
+			clusterStatus.Message = err.Error()
+			errs = append(errs, fmt.Errorf("apply work on cluster %s: %w", work.ClusterName, err))
+		} else {
+			clusterStatus.Applied = true
+			available, err := readAvailableReplicas(ctx, target.Clientset, m.namespace, work.Manifest.Name)
+			if err != nil {
+				clusterStatus.Message = fmt.Sprintf("applied but could not read back status: %v", err)
+				errs = append(errs, fmt.Errorf("read status on cluster %s: %w", work.ClusterName, err))
+			} else {
+				clusterStatus.AvailableReplicas = available
+			}
+		}
+		statuses = append(statuses, clusterStatus)
+	}
+
+	if m.reporter != nil {
+		if err := m.reporter.ReportResourceBinding(ctx, task.AgentID, agentdeploymentv1.ResourceBindingStatus{Clusters: statuses}); err != nil {
+			errs = append(errs, fmt.Errorf("report resource binding: %w", err))
+		}
+	}
+
+	return combineErrors(errs)
+}
+
+// renderWorks builds one Work per targeted cluster, computing each
+// cluster's replica share from the PropagationPolicy.
+func renderWorks(task model.DeploymentTask, policy PropagationPolicy, targets map[string]MemberCluster) []Work {
+	works := make([]Work, 0, len(targets))
+	for name := range targets {
+		replicas := policy.replicasFor(name, task.Replicas)
+		works = append(works, Work{
+			ClusterName: name,
+			Manifest:    buildDeployment(task, replicas),
+		})
+	}
+	return works
+}
+
+func buildDeployment(task model.DeploymentTask, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: task.AgentID,
+			Labels: map[string]string{
+				"tenant": task.TenantID,
+				"agent":  task.AgentID,
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(replicas),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"agent": task.AgentID},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"agent": task.AgentID},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  task.AgentID,
+							Image: task.ContainerImg,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applyWork(ctx context.Context, clientset kubernetes.Interface, namespace string, work Work) error {
+	deployments := clientset.AppsV1().Deployments(namespace)
+
+	existing, err := deployments.Get(ctx, work.Manifest.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err := deployments.Create(ctx, work.Manifest, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	updated := existing.DeepCopy()
+	updated.Spec.Replicas = work.Manifest.Spec.Replicas
+	updated.Spec.Template.Spec.Containers = work.Manifest.Spec.Template.Spec.Containers
+	_, err = deployments.Update(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// readAvailableReplicas reads back the Deployment's own status from the
+// member cluster rather than trusting the manifest we just applied, since
+// a successful apply only means the spec was accepted, not that any pod
+// has actually come up.
+func readAvailableReplicas(ctx context.Context, clientset kubernetes.Interface, namespace, name string) (int32, error) {
+	deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return deployment.Status.AvailableReplicas, nil
+}
+
+// selectClusters returns the clusters whose labels are a superset of
+// selector, keyed by name for quick lookup while rendering Work objects. A
+// nil selector matches every registered cluster.
+func selectClusters(clusters []MemberCluster, selector map[string]string) map[string]MemberCluster {
+	matched := make(map[string]MemberCluster)
+	for _, c := range clusters {
+		if labelsMatch(c.Labels, selector) {
+			matched[c.Name] = c
+		}
+	}
+	return matched
+}
+
+func labelsMatch(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func combineErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	msg := errs[0].Error()
+	for _, e := range errs[1:] {
+		msg += "; " + e.Error()
+	}
+	return fmt.Errorf("%s", msg)
+}
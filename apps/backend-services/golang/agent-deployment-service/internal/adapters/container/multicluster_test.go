@@ -0,0 +1,137 @@
+package container
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	agentdeploymentv1 "github.com/thomas-caarter-aic/agent-deployment-service/pkg/apis/agentdeployment/v1"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// fakeClusterRegistry returns a fixed set of member clusters, each backed by
+// its own fake clientset so a test can assert per-cluster effects.
+type fakeClusterRegistry struct {
+	clusters []MemberCluster
+}
+
+func (r *fakeClusterRegistry) Clusters(_ context.Context) ([]MemberCluster, error) {
+	return r.clusters, nil
+}
+
+// recordingStatusReporter saves the last binding it was asked to report.
+type recordingStatusReporter struct {
+	lastAgentID string
+	lastBinding agentdeploymentv1.ResourceBindingStatus
+}
+
+func (r *recordingStatusReporter) ReportResourceBinding(_ context.Context, agentID string, binding agentdeploymentv1.ResourceBindingStatus) error {
+	r.lastAgentID = agentID
+	r.lastBinding = binding
+	return nil
+}
+
+func TestMultiClusterAdapter_DeployAgent_PropagatesAndReportsStatus(t *testing.T) {
+	clusterA := fake.NewSimpleClientset()
+	clusterB := fake.NewSimpleClientset()
+	registry := &fakeClusterRegistry{clusters: []MemberCluster{
+		{Name: "cluster-a", Labels: map[string]string{"region": "us"}, Clientset: clusterA},
+		{Name: "cluster-b", Labels: map[string]string{"region": "eu"}, Clientset: clusterB},
+	}}
+	reporter := &recordingStatusReporter{}
+	policy := PropagationPolicy{ReplicaScheduling: Duplicated}
+	adapter := NewMultiClusterAdapter(registry, func(model.DeploymentTask) PropagationPolicy { return policy }, reporter, "default")
+
+	task := model.DeploymentTask{TenantID: "tenant-test", AgentID: "agent-test", ContainerImg: "test-image:v1", Replicas: 2}
+	if err := adapter.DeployAgent(context.Background(), task); err != nil {
+		t.Fatalf("DeployAgent: %v", err)
+	}
+
+	for _, cs := range []kubernetes.Interface{clusterA, clusterB} {
+		deployment, err := cs.AppsV1().Deployments("default").Get(context.Background(), "agent-test", metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("expected the Deployment to be applied on every matched cluster: %v", err)
+		}
+		if *deployment.Spec.Replicas != 2 {
+			t.Fatalf("expected Duplicated scheduling to apply the full replica count, got %d", *deployment.Spec.Replicas)
+		}
+	}
+
+	if reporter.lastAgentID != "agent-test" || len(reporter.lastBinding.Clusters) != 2 {
+		t.Fatalf("expected status reported for both clusters, got %+v", reporter.lastBinding)
+	}
+	for _, cs := range reporter.lastBinding.Clusters {
+		if !cs.Applied {
+			t.Fatalf("expected cluster %s to be recorded as applied, got %+v", cs.ClusterName, cs)
+		}
+	}
+}
+
+func TestMultiClusterAdapter_DeployAgent_SelectorExcludesNonMatchingClusters(t *testing.T) {
+	clusterA := fake.NewSimpleClientset()
+	clusterB := fake.NewSimpleClientset()
+	registry := &fakeClusterRegistry{clusters: []MemberCluster{
+		{Name: "cluster-a", Labels: map[string]string{"region": "us"}, Clientset: clusterA},
+		{Name: "cluster-b", Labels: map[string]string{"region": "eu"}, Clientset: clusterB},
+	}}
+	policy := PropagationPolicy{ClusterSelector: map[string]string{"region": "us"}}
+	adapter := NewMultiClusterAdapter(registry, func(model.DeploymentTask) PropagationPolicy { return policy }, nil, "default")
+
+	task := model.DeploymentTask{TenantID: "tenant-test", AgentID: "agent-test", ContainerImg: "test-image:v1", Replicas: 1}
+	if err := adapter.DeployAgent(context.Background(), task); err != nil {
+		t.Fatalf("DeployAgent: %v", err)
+	}
+
+	if _, err := clusterA.AppsV1().Deployments("default").Get(context.Background(), "agent-test", metav1.GetOptions{}); err != nil {
+		t.Fatalf("expected cluster-a (matches selector) to receive the Deployment: %v", err)
+	}
+	if _, err := clusterB.AppsV1().Deployments("default").Get(context.Background(), "agent-test", metav1.GetOptions{}); err == nil {
+		t.Fatal("expected cluster-b (doesn't match selector) to be skipped")
+	}
+}
+
+func TestMultiClusterAdapter_DeployAgent_NoMatchingClustersErrors(t *testing.T) {
+	registry := &fakeClusterRegistry{clusters: []MemberCluster{
+		{Name: "cluster-a", Labels: map[string]string{"region": "us"}, Clientset: fake.NewSimpleClientset()},
+	}}
+	policy := PropagationPolicy{ClusterSelector: map[string]string{"region": "apac"}}
+	adapter := NewMultiClusterAdapter(registry, func(model.DeploymentTask) PropagationPolicy { return policy }, nil, "default")
+
+	err := adapter.DeployAgent(context.Background(), model.DeploymentTask{AgentID: "agent-test"})
+	if err == nil {
+		t.Fatal("expected an error when the propagation policy matches no member clusters")
+	}
+}
+
+func TestSelectClusters_NilSelectorMatchesEverything(t *testing.T) {
+	clusters := []MemberCluster{
+		{Name: "cluster-a", Labels: map[string]string{"region": "us"}},
+		{Name: "cluster-b", Labels: nil},
+	}
+
+	matched := selectClusters(clusters, nil)
+	if len(matched) != 2 {
+		t.Fatalf("expected a nil selector to match every cluster, got %+v", matched)
+	}
+}
+
+func TestReadAvailableReplicas_ReadsLiveStatusNotSpec(t *testing.T) {
+	cs := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: int32Ptr(5)},
+		Status:     appsv1.DeploymentStatus{AvailableReplicas: 2},
+	})
+
+	available, err := readAvailableReplicas(context.Background(), cs, "default", "agent-test")
+	if err != nil {
+		t.Fatalf("readAvailableReplicas: %v", err)
+	}
+	if available != 2 {
+		t.Fatalf("expected the live status (2), not the desired spec (5), got %d", available)
+	}
+}
@@ -0,0 +1,104 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// podDeleteTimeout bounds how long UpdateAgent waits for a Pod's
+// terminationGracePeriodSeconds to actually remove it from etcd before
+// recreating it under the same name.
+const podDeleteTimeout = 45 * time.Second
+
+// PodAdapter deploys an agent as a single bare Pod instead of a Deployment,
+// the way the operator's K8sAdapter does for lightweight, ephemeral agents
+// that don't need rollout machinery.
+type PodAdapter struct {
+	clientset *kubernetes.Clientset
+	namespace string
+}
+
+// NewPodAdapter initializes a Kubernetes client for bare-Pod deployments.
+func NewPodAdapter(namespace string) (*PodAdapter, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PodAdapter{clientset: clientset, namespace: namespace}, nil
+}
+
+func (p *PodAdapter) DeployAgent(ctx context.Context, task model.DeploymentTask) error {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: task.AgentID,
+			Labels: map[string]string{
+				"tenant": task.TenantID,
+				"agent":  task.AgentID,
+			},
+		},
+		Spec: PodSpecBuilder{}.Build(task),
+	}
+
+	_, err := p.clientset.CoreV1().Pods(p.namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("deploy pod error: %w", err)
+	}
+	return nil
+}
+
+// UpdateAgent replaces the Pod, since a Pod's container image can't be
+// updated in place. Delete only marks the Pod for termination, so this
+// waits for it to actually disappear before recreating it under the same
+// name — otherwise Create races the old Pod's terminationGracePeriod and
+// fails with AlreadyExists.
+func (p *PodAdapter) UpdateAgent(ctx context.Context, task model.DeploymentTask) error {
+	if err := p.DeleteAgent(ctx, task); err != nil {
+		return err
+	}
+	if err := p.waitDeleted(ctx, task.AgentID); err != nil {
+		return err
+	}
+	return p.DeployAgent(ctx, task)
+}
+
+// waitDeleted polls until name is gone from the API server or
+// podDeleteTimeout elapses.
+func (p *PodAdapter) waitDeleted(ctx context.Context, name string) error {
+	waitCtx, cancel := context.WithTimeout(ctx, podDeleteTimeout)
+	defer cancel()
+
+	err := wait.PollUntilContextCancel(waitCtx, 500*time.Millisecond, true, func(ctx context.Context) (bool, error) {
+		_, err := p.clientset.CoreV1().Pods(p.namespace).Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return fmt.Errorf("wait for pod %s to terminate: %w", name, err)
+	}
+	return nil
+}
+
+func (p *PodAdapter) DeleteAgent(ctx context.Context, task model.DeploymentTask) error {
+	return p.clientset.CoreV1().Pods(p.namespace).Delete(ctx, task.AgentID, metav1.DeleteOptions{})
+}
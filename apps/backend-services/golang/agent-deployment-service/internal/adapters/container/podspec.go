@@ -0,0 +1,99 @@
+package container
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// PodSpecBuilder renders a corev1.PodSpec from a DeploymentTask. Both
+// KubernetesAdapter (wrapped in a Deployment) and PodAdapter (applied bare)
+// share it so pull policy, resources, scheduling and DNS overrides aren't
+// duplicated between the two.
+type PodSpecBuilder struct{}
+
+// Build renders the single-container PodSpec for task.
+func (PodSpecBuilder) Build(task model.DeploymentTask) corev1.PodSpec {
+	spec := corev1.PodSpec{
+		Containers: []corev1.Container{
+			{
+				Name:            task.AgentID,
+				Image:           task.ContainerImg,
+				ImagePullPolicy: toPullPolicy(task.PullPolicy),
+				Resources:       toResourceRequirements(task.Resources),
+			},
+		},
+		NodeSelector:       task.NodeSelector,
+		ServiceAccountName: task.ServiceAccount,
+		Tolerations:        toTolerations(task.Tolerations),
+		ImagePullSecrets:   toLocalObjectRefs(task.ImagePullSecrets),
+	}
+
+	if len(task.DNSNameservers) > 0 {
+		spec.DNSPolicy = corev1.DNSNone
+		spec.DNSConfig = &corev1.PodDNSConfig{Nameservers: task.DNSNameservers}
+	}
+
+	return spec
+}
+
+func toPullPolicy(p model.PullPolicy) corev1.PullPolicy {
+	switch p {
+	case model.PullAlways:
+		return corev1.PullAlways
+	case model.PullNever:
+		return corev1.PullNever
+	case model.PullIfNotPresent:
+		return corev1.PullIfNotPresent
+	default:
+		return ""
+	}
+}
+
+func toResourceRequirements(r model.ResourceRequirements) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Limits:   toResourceList(r.Limits),
+		Requests: toResourceList(r.Requests),
+	}
+}
+
+func toResourceList(list model.ResourceList) corev1.ResourceList {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make(corev1.ResourceList, len(list))
+	for name, quantity := range list {
+		if q, err := resource.ParseQuantity(quantity); err == nil {
+			out[corev1.ResourceName(name)] = q
+		}
+	}
+	return out
+}
+
+func toTolerations(tolerations []model.Toleration) []corev1.Toleration {
+	if len(tolerations) == 0 {
+		return nil
+	}
+	out := make([]corev1.Toleration, len(tolerations))
+	for i, t := range tolerations {
+		out[i] = corev1.Toleration{
+			Key:      t.Key,
+			Operator: corev1.TolerationOperator(t.Operator),
+			Value:    t.Value,
+			Effect:   corev1.TaintEffect(t.Effect),
+		}
+	}
+	return out
+}
+
+func toLocalObjectRefs(secretNames []string) []corev1.LocalObjectReference {
+	if len(secretNames) == 0 {
+		return nil
+	}
+	out := make([]corev1.LocalObjectReference, len(secretNames))
+	for i, name := range secretNames {
+		out[i] = corev1.LocalObjectReference{Name: name}
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+package container
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+func TestPodSpecBuilder_Build(t *testing.T) {
+	task := model.DeploymentTask{
+		AgentID:          "agent-test",
+		ContainerImg:     "test-image:v1",
+		PullPolicy:       model.PullAlways,
+		ImagePullSecrets: []string{"registry-creds"},
+		Resources: model.ResourceRequirements{
+			Limits:   model.ResourceList{"cpu": "500m", "memory": "256Mi"},
+			Requests: model.ResourceList{"cpu": "100m"},
+		},
+		NodeSelector:   map[string]string{"disktype": "ssd"},
+		ServiceAccount: "agent-runner",
+		Tolerations: []model.Toleration{
+			{Key: "dedicated", Operator: "Equal", Value: "agents", Effect: "NoSchedule"},
+		},
+		DNSNameservers: []string{"10.0.0.10"},
+	}
+
+	spec := PodSpecBuilder{}.Build(task)
+
+	if len(spec.Containers) != 1 {
+		t.Fatalf("expected exactly one container, got %d", len(spec.Containers))
+	}
+	container := spec.Containers[0]
+	if container.Name != task.AgentID || container.Image != task.ContainerImg {
+		t.Fatalf("container name/image not wired from task: %+v", container)
+	}
+	if container.ImagePullPolicy != corev1.PullAlways {
+		t.Fatalf("expected PullAlways, got %q", container.ImagePullPolicy)
+	}
+	if container.Resources.Limits.Cpu().String() != "500m" {
+		t.Fatalf("expected cpu limit 500m, got %v", container.Resources.Limits.Cpu())
+	}
+	if container.Resources.Requests.Cpu().String() != "100m" {
+		t.Fatalf("expected cpu request 100m, got %v", container.Resources.Requests.Cpu())
+	}
+	if spec.NodeSelector["disktype"] != "ssd" {
+		t.Fatalf("node selector not wired, got %+v", spec.NodeSelector)
+	}
+	if spec.ServiceAccountName != "agent-runner" {
+		t.Fatalf("service account not wired, got %q", spec.ServiceAccountName)
+	}
+	if len(spec.Tolerations) != 1 || spec.Tolerations[0].Key != "dedicated" {
+		t.Fatalf("tolerations not wired, got %+v", spec.Tolerations)
+	}
+	if len(spec.ImagePullSecrets) != 1 || spec.ImagePullSecrets[0].Name != "registry-creds" {
+		t.Fatalf("image pull secrets not wired, got %+v", spec.ImagePullSecrets)
+	}
+	if spec.DNSPolicy != corev1.DNSNone || spec.DNSConfig == nil || len(spec.DNSConfig.Nameservers) != 1 {
+		t.Fatalf("DNS override not wired, got policy=%q config=%+v", spec.DNSPolicy, spec.DNSConfig)
+	}
+}
+
+func TestPodSpecBuilder_Build_DefaultsLeaveDNSAlone(t *testing.T) {
+	spec := PodSpecBuilder{}.Build(model.DeploymentTask{AgentID: "agent-test", ContainerImg: "test-image:v1"})
+
+	if spec.DNSPolicy != "" || spec.DNSConfig != nil {
+		t.Fatalf("expected no DNS override without DNSNameservers, got policy=%q config=%+v", spec.DNSPolicy, spec.DNSConfig)
+	}
+	if spec.Containers[0].ImagePullPolicy != "" {
+		t.Fatalf("expected empty pull policy to pass through unset, got %q", spec.Containers[0].ImagePullPolicy)
+	}
+	if spec.Tolerations != nil || spec.ImagePullSecrets != nil {
+		t.Fatalf("expected nil slices for unset tolerations/secrets, got %+v / %+v", spec.Tolerations, spec.ImagePullSecrets)
+	}
+}
+
+func TestToResourceList_SkipsUnparseableQuantities(t *testing.T) {
+	list := toResourceList(model.ResourceList{"cpu": "not-a-quantity", "memory": "128Mi"})
+
+	if _, ok := list[corev1.ResourceCPU]; ok {
+		t.Fatalf("expected unparseable cpu quantity to be dropped, got %+v", list)
+	}
+	mem := list[corev1.ResourceMemory]
+	if mem.String() != "128Mi" {
+		t.Fatalf("expected memory 128Mi to survive, got %+v", list)
+	}
+}
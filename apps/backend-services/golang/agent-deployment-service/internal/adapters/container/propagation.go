@@ -0,0 +1,56 @@
+package container
+
+// ReplicaSchedulingMode decides how an agent's total replica count is
+// spread across the clusters a PropagationPolicy selects.
+type ReplicaSchedulingMode string
+
+const (
+	// Duplicated deploys the full replica count to every selected
+	// cluster.
+	Duplicated ReplicaSchedulingMode = "Duplicated"
+	// Divided splits the replica count across selected clusters
+	// according to ClusterWeights.
+	Divided ReplicaSchedulingMode = "Divided"
+)
+
+// ClusterWeight gives a named cluster a share of the total replica count
+// when ReplicaSchedulingMode is Divided.
+type ClusterWeight struct {
+	ClusterName string
+	Weight      int32
+}
+
+// PropagationPolicy selects which member clusters a DeploymentTask fans out
+// to and how replicas are scheduled across them.
+type PropagationPolicy struct {
+	// ClusterSelector matches clusters registered in the ClusterRegistry
+	// by label. A nil selector matches every registered cluster.
+	ClusterSelector map[string]string
+	// ReplicaScheduling chooses between duplicating or dividing replicas
+	// across the selected clusters.
+	ReplicaScheduling ReplicaSchedulingMode
+	// ClusterWeights gives the per-cluster share of replicas when
+	// ReplicaScheduling is Divided. Ignored otherwise.
+	ClusterWeights []ClusterWeight
+}
+
+// replicasFor computes how many replicas a single cluster should run given
+// the policy and the task's total desired replica count.
+func (p PropagationPolicy) replicasFor(clusterName string, total int32) int32 {
+	if p.ReplicaScheduling != Divided {
+		return total
+	}
+
+	var totalWeight int32
+	var clusterWeight int32
+	for _, w := range p.ClusterWeights {
+		totalWeight += w.Weight
+		if w.ClusterName == clusterName {
+			clusterWeight = w.Weight
+		}
+	}
+	if totalWeight == 0 {
+		return 0
+	}
+	return total * clusterWeight / totalWeight
+}
@@ -0,0 +1,47 @@
+package container
+
+import "testing"
+
+func TestPropagationPolicy_ReplicasFor_Duplicated(t *testing.T) {
+	policy := PropagationPolicy{ReplicaScheduling: Duplicated}
+
+	if got := policy.replicasFor("cluster-a", 6); got != 6 {
+		t.Fatalf("expected Duplicated to give every cluster the full count, got %d", got)
+	}
+}
+
+func TestPropagationPolicy_ReplicasFor_Divided(t *testing.T) {
+	policy := PropagationPolicy{
+		ReplicaScheduling: Divided,
+		ClusterWeights: []ClusterWeight{
+			{ClusterName: "cluster-a", Weight: 3},
+			{ClusterName: "cluster-b", Weight: 1},
+		},
+	}
+
+	if got := policy.replicasFor("cluster-a", 8); got != 6 {
+		t.Fatalf("expected cluster-a's 3/4 share of 8 to be 6, got %d", got)
+	}
+	if got := policy.replicasFor("cluster-b", 8); got != 2 {
+		t.Fatalf("expected cluster-b's 1/4 share of 8 to be 2, got %d", got)
+	}
+}
+
+func TestPropagationPolicy_ReplicasFor_DividedUnknownClusterGetsZero(t *testing.T) {
+	policy := PropagationPolicy{
+		ReplicaScheduling: Divided,
+		ClusterWeights:    []ClusterWeight{{ClusterName: "cluster-a", Weight: 1}},
+	}
+
+	if got := policy.replicasFor("cluster-c", 10); got != 0 {
+		t.Fatalf("expected a cluster with no weight entry to get 0 replicas, got %d", got)
+	}
+}
+
+func TestPropagationPolicy_ReplicasFor_DividedNoWeightsGivesZero(t *testing.T) {
+	policy := PropagationPolicy{ReplicaScheduling: Divided}
+
+	if got := policy.replicasFor("cluster-a", 10); got != 0 {
+		t.Fatalf("expected Divided with no weights at all to avoid a divide-by-zero and give 0, got %d", got)
+	}
+}
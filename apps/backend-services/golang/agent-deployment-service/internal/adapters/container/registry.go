@@ -0,0 +1,40 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// AdapterRegistry selects the Adapter a DeploymentTask is routed to by its
+// Runtime field, so the orchestrator can target Kubernetes Deployments,
+// bare Pods and plain Docker/containerd containers through the same
+// SubmitTask API.
+type AdapterRegistry struct {
+	adapters map[model.Runtime]Adapter
+}
+
+// NewAdapterRegistry builds an empty registry; register backends with
+// Register.
+func NewAdapterRegistry() *AdapterRegistry {
+	return &AdapterRegistry{adapters: make(map[model.Runtime]Adapter)}
+}
+
+// Register associates runtime with adapter, replacing any prior adapter
+// registered for that runtime.
+func (r *AdapterRegistry) Register(runtime model.Runtime, adapter Adapter) {
+	r.adapters[runtime] = adapter
+}
+
+// For resolves the Adapter for runtime, defaulting an empty runtime to
+// RuntimeKubernetesDeployment.
+func (r *AdapterRegistry) For(runtime model.Runtime) (Adapter, error) {
+	if runtime == "" {
+		runtime = model.RuntimeKubernetesDeployment
+	}
+	adapter, ok := r.adapters[runtime]
+	if !ok {
+		return nil, fmt.Errorf("no adapter registered for runtime %q", runtime)
+	}
+	return adapter, nil
+}
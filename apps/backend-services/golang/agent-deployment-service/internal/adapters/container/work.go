@@ -0,0 +1,12 @@
+package container
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// Work is the rendered manifest a MultiClusterAdapter applies to a single
+// member cluster on behalf of a DeploymentTask.
+type Work struct {
+	ClusterName string
+	Manifest    *appsv1.Deployment
+}
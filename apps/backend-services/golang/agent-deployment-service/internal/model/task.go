@@ -0,0 +1,127 @@
+// Package model holds the domain types shared between the orchestrator and
+// its container adapters.
+package model
+
+import "time"
+
+// Chart-rendering actions, dispatched by Orchestrator.handleTask to the
+// Helm adapter instead of the Kubernetes one.
+const (
+	ActionInstallChart   = "INSTALL_CHART"
+	ActionUpgradeChart   = "UPGRADE_CHART"
+	ActionUninstallChart = "UNINSTALL_CHART"
+)
+
+// Runtime selects which container.Adapter backend a DeploymentTask targets.
+// container.AdapterRegistry is keyed by this value.
+type Runtime string
+
+const (
+	// RuntimeKubernetesDeployment deploys the agent as a Kubernetes
+	// Deployment. This is the default when Runtime is empty.
+	RuntimeKubernetesDeployment Runtime = "kubernetes-deployment"
+	// RuntimeKubernetesPod deploys the agent as a single bare Pod, for
+	// lightweight ephemeral agents that don't need a Deployment's
+	// rollout machinery.
+	RuntimeKubernetesPod Runtime = "kubernetes-pod"
+	// RuntimeDocker runs the agent as a plain Docker/containerd
+	// container outside Kubernetes entirely.
+	RuntimeDocker Runtime = "docker"
+)
+
+// PullPolicy mirrors corev1.PullPolicy without pulling a Kubernetes
+// dependency into the domain model.
+type PullPolicy string
+
+const (
+	PullAlways       PullPolicy = "Always"
+	PullIfNotPresent PullPolicy = "IfNotPresent"
+	PullNever        PullPolicy = "Never"
+)
+
+// ResourceList is a set of quantity strings keyed by resource name, e.g.
+// {"cpu": "500m", "memory": "256Mi"}.
+type ResourceList map[string]string
+
+// ResourceRequirements mirrors corev1.ResourceRequirements.
+type ResourceRequirements struct {
+	Limits   ResourceList
+	Requests ResourceList
+}
+
+// Toleration mirrors corev1.Toleration.
+type Toleration struct {
+	Key      string
+	Operator string
+	Value    string
+	Effect   string
+}
+
+// Priority ranks a DeploymentTask against others from the same tenant.
+// Within a tenant's turn in the Orchestrator's round-robin schedule,
+// Critical tasks drain before Normal, and Normal before Low.
+type Priority string
+
+const (
+	PriorityCritical Priority = "Critical"
+	PriorityNormal   Priority = "Normal"
+	PriorityLow      Priority = "Low"
+)
+
+// DeploymentTask describes a single unit of work submitted to the
+// Orchestrator: deploy, update or delete an agent's workload.
+type DeploymentTask struct {
+	TenantID     string
+	AgentID      string
+	ContainerImg string
+	Action       string
+	// Replicas is the total desired replica count. Single-cluster
+	// adapters may ignore it; container.MultiClusterAdapter uses it as
+	// the total a PropagationPolicy duplicates or divides across member
+	// clusters.
+	Replicas int32
+	// Timeout bounds how long an adapter waits for its operation (e.g. a
+	// Helm install/upgrade/uninstall) to finish.
+	Timeout time.Duration
+
+	// Chart fields, used when Action is one of the ActionInstallChart /
+	// ActionUpgradeChart / ActionUninstallChart values.
+	ChartRef    string
+	Values      map[string]interface{}
+	ReleaseName string
+
+	// WaitForReady tells the Orchestrator to block a CREATE/UPDATE task on
+	// the deployed workload reaching readiness (see internal/statuscheck)
+	// before reporting it done.
+	WaitForReady bool
+
+	// Runtime selects the container.Adapter backend this task is routed
+	// to. Empty defaults to RuntimeKubernetesDeployment.
+	Runtime Runtime
+	// PullPolicy controls when the runtime re-pulls ContainerImg.
+	PullPolicy PullPolicy
+	// ImagePullSecrets names the secrets used to pull ContainerImg from a
+	// private registry.
+	ImagePullSecrets []string
+	// Resources bounds the CPU/memory the agent's container may use. The
+	// Docker runtime applies only the memory limit.
+	Resources ResourceRequirements
+	// NodeSelector and Tolerations constrain which nodes the agent may be
+	// scheduled onto. Kubernetes-backed runtimes only.
+	NodeSelector map[string]string
+	Tolerations  []Toleration
+	// ServiceAccount is the Kubernetes ServiceAccount the agent's Pod runs
+	// as. Kubernetes-backed runtimes only.
+	ServiceAccount string
+	// DNSNameservers overrides the Pod's resolver configuration. Empty
+	// leaves the cluster default DNS policy in place.
+	DNSNameservers []string
+
+	// Priority places this task ahead of or behind other tasks from the
+	// same tenant. Defaults to PriorityNormal.
+	Priority Priority
+	// MaxRetries bounds how many times the Orchestrator retries a failing
+	// task with exponential backoff before giving up. Defaults to
+	// orchestrator.DefaultMaxRetries when zero.
+	MaxRetries int
+}
@@ -0,0 +1,209 @@
+// Package operator promotes the CRD-driven reconciliation path referenced
+// in docs/alt/main.go into a first-class subsystem that runs alongside the
+// queue-based Orchestrator.
+package operator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	agentdeploymentv1 "github.com/thomas-caarter-aic/agent-deployment-service/pkg/apis/agentdeployment/v1"
+	clientset "github.com/thomas-caarter-aic/agent-deployment-service/pkg/generated/clientset/versioned"
+	informers "github.com/thomas-caarter-aic/agent-deployment-service/pkg/generated/informers/externalversions"
+	listers "github.com/thomas-caarter-aic/agent-deployment-service/pkg/generated/listers/agentdeployment/v1"
+)
+
+// finalizerName marks an Agent as having live cluster resources that must
+// be cleaned up before the CR itself is allowed to be removed.
+const finalizerName = "agentdeployment.ai.example.com/finalizer"
+
+// Controller reconciles Agent custom resources against the cluster.
+type Controller struct {
+	agentClient clientset.Interface
+	informer    cache.SharedIndexInformer
+	lister      listers.AgentLister
+	queue       workqueue.RateLimitingInterface
+	reconciler  Reconciler
+	maxRetries  int
+	namespace   string
+}
+
+// NewController wires a Controller from an Agent clientset, watching Agents
+// in namespace through a shared informer with the given resync period.
+func NewController(agentClient clientset.Interface, kubeClient kubernetes.Interface, reconciler Reconciler, namespace string, resyncPeriod time.Duration) *Controller {
+	factory := informers.NewSharedInformerFactory(agentClient, namespace, resyncPeriod)
+	informer := factory.Agentdeployment().Informer()
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "agent-deployment")
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if key, err := cache.MetaNamespaceKeyFunc(obj); err == nil {
+				queue.Add(key)
+			}
+		},
+		UpdateFunc: func(_, newObj interface{}) {
+			if key, err := cache.MetaNamespaceKeyFunc(newObj); err == nil {
+				queue.Add(key)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			// By the time this fires the Agent is already gone from the
+			// API server. Finalize() already ran cleanup during the
+			// reconcile that observed DeletionTimestamp, so there is
+			// nothing left to do here — this handler only exists so a
+			// stale queue entry for the deleted key gets forgotten.
+			if key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj); err == nil {
+				queue.Forget(key)
+			}
+		},
+	})
+
+	return &Controller{
+		agentClient: agentClient,
+		informer:    informer,
+		lister:      factory.Agentdeployment().Lister(),
+		queue:       queue,
+		reconciler:  reconciler,
+		maxRetries:  5,
+		namespace:   namespace,
+	}
+}
+
+// Run starts the informer and reconcile workers until stopCh is closed.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	klog.Info("Starting agent informer")
+	go c.informer.Run(stopCh)
+
+	if !cache.WaitForCacheSync(stopCh, c.informer.HasSynced) {
+		klog.Error("Failed to sync agent informer cache")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	klog.Info("Stopping agent controller")
+}
+
+func (c *Controller) worker() {
+	for c.processNextItem() {
+	}
+}
+
+func (c *Controller) processNextItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.reconcileKey(key.(string)); err != nil {
+		if c.queue.NumRequeues(key) < c.maxRetries {
+			klog.Errorf("Error reconciling %v: %v", key, err)
+			c.queue.AddRateLimited(key)
+			return true
+		}
+		utilruntime.HandleError(err)
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Controller) reconcileKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	agent, err := c.lister.Agents(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		klog.Infof("Agent %s no longer exists", key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if agent.DeletionTimestamp != nil {
+		return c.finalize(agent)
+	}
+
+	if agent.Generation == agent.Status.ObservedGeneration && agent.Status.Phase == agentdeploymentv1.AgentPhaseRunning {
+		// Nothing changed since the last observed generation and the
+		// rollout already converged; skip redundant work.
+		return nil
+	}
+
+	if !hasFinalizer(agent) {
+		agent = agent.DeepCopy()
+		agent.Finalizers = append(agent.Finalizers, finalizerName)
+		updated, err := c.agentClient.AgentdeploymentV1().Agents(namespace).Update(context.TODO(), agent, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("add finalizer to agent %s: %w", key, err)
+		}
+		agent = updated
+	}
+
+	status, err := c.reconciler.Reconcile(context.TODO(), agent)
+	if err != nil {
+		return err
+	}
+
+	agent = agent.DeepCopy()
+	agent.Status = status
+	_, err = c.agentClient.AgentdeploymentV1().Agents(namespace).UpdateStatus(context.TODO(), agent, metav1.UpdateOptions{})
+	return err
+}
+
+// finalize runs the reconciler's cleanup for an Agent pending deletion and
+// then drops our finalizer so the API server can remove the CR. Running
+// cleanup here — before the object disappears — is what makes it safe to
+// just forget the key once DeleteFunc observes the actual removal.
+func (c *Controller) finalize(agent *agentdeploymentv1.Agent) error {
+	if !hasFinalizer(agent) {
+		return nil
+	}
+
+	if err := c.reconciler.Finalize(context.TODO(), agent); err != nil {
+		return fmt.Errorf("finalize agent %s/%s: %w", agent.Namespace, agent.Name, err)
+	}
+
+	agent = agent.DeepCopy()
+	agent.Finalizers = removeFinalizer(agent.Finalizers)
+	_, err := c.agentClient.AgentdeploymentV1().Agents(agent.Namespace).Update(context.TODO(), agent, metav1.UpdateOptions{})
+	return err
+}
+
+func hasFinalizer(agent *agentdeploymentv1.Agent) bool {
+	for _, f := range agent.Finalizers {
+		if f == finalizerName {
+			return true
+		}
+	}
+	return false
+}
+
+func removeFinalizer(finalizers []string) []string {
+	kept := finalizers[:0]
+	for _, f := range finalizers {
+		if f != finalizerName {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,31 @@
+package operator
+
+import (
+	"testing"
+
+	agentdeploymentv1 "github.com/thomas-caarter-aic/agent-deployment-service/pkg/apis/agentdeployment/v1"
+)
+
+func TestHasFinalizer(t *testing.T) {
+	agent := &agentdeploymentv1.Agent{}
+	if hasFinalizer(agent) {
+		t.Fatal("expected an Agent with no finalizers to report false")
+	}
+
+	agent.Finalizers = []string{"other.example.com/finalizer", finalizerName}
+	if !hasFinalizer(agent) {
+		t.Fatal("expected hasFinalizer to find finalizerName among other finalizers")
+	}
+}
+
+func TestRemoveFinalizer(t *testing.T) {
+	kept := removeFinalizer([]string{"a.example.com/finalizer", finalizerName, "b.example.com/finalizer"})
+	if len(kept) != 2 || kept[0] != "a.example.com/finalizer" || kept[1] != "b.example.com/finalizer" {
+		t.Fatalf("expected finalizerName to be removed and the rest kept in order, got %v", kept)
+	}
+
+	kept = removeFinalizer([]string{finalizerName})
+	if len(kept) != 0 {
+		t.Fatalf("expected removing the only finalizer to leave an empty slice, got %v", kept)
+	}
+}
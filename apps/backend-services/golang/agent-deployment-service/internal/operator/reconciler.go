@@ -0,0 +1,124 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	agentdeploymentv1 "github.com/thomas-caarter-aic/agent-deployment-service/pkg/apis/agentdeployment/v1"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/adapters/container"
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// Reconciler drives the live state of an Agent towards its desired spec.
+type Reconciler interface {
+	Reconcile(ctx context.Context, agent *agentdeploymentv1.Agent) (agentdeploymentv1.AgentStatus, error)
+	// Finalize runs cleanup for an Agent that is being deleted. It must be
+	// safe to call more than once.
+	Finalize(ctx context.Context, agent *agentdeploymentv1.Agent) error
+}
+
+// K8sAdapter is the Reconciler that drives an Agent's Deployment through
+// the same container.Adapter the queue-based Orchestrator uses.
+type K8sAdapter struct {
+	kubeClient kubernetes.Interface
+	k8s        container.Adapter
+	namespace  string
+}
+
+// NewK8sAdapter builds a Reconciler backed by the given Kubernetes client
+// and container adapter.
+func NewK8sAdapter(kubeClient kubernetes.Interface, k8s container.Adapter, namespace string) *K8sAdapter {
+	return &K8sAdapter{kubeClient: kubeClient, k8s: k8s, namespace: namespace}
+}
+
+// Reconcile diffs the Agent's desired spec against the live Deployment
+// created by KubernetesAdapter.DeployAgent, patches it in place when they
+// diverge, and returns the status to persist via the /status subresource.
+func (a *K8sAdapter) Reconcile(ctx context.Context, agent *agentdeploymentv1.Agent) (agentdeploymentv1.AgentStatus, error) {
+	status := agent.Status
+
+	task := model.DeploymentTask{
+		TenantID:     agent.Spec.TenantID,
+		AgentID:      agent.Name,
+		ContainerImg: agent.Spec.ContainerImage,
+		Action:       "CREATE",
+		Replicas:     agent.Spec.Replicas,
+	}
+
+	deployment, err := a.kubeClient.AppsV1().Deployments(a.namespace).Get(ctx, agent.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if err := a.k8s.DeployAgent(ctx, task); err != nil {
+			return status, fmt.Errorf("deploy agent %s: %w", agent.Name, err)
+		}
+		status.Phase = agentdeploymentv1.AgentPhaseProgressing
+	} else if err != nil {
+		return status, fmt.Errorf("get deployment for agent %s: %w", agent.Name, err)
+	} else if driftsFromSpec(deployment, agent.Spec) {
+		patched := deployment.DeepCopy()
+		patched.Spec.Replicas = &agent.Spec.Replicas
+		if len(patched.Spec.Template.Spec.Containers) > 0 {
+			patched.Spec.Template.Spec.Containers[0].Image = agent.Spec.ContainerImage
+		}
+		if _, err := a.kubeClient.AppsV1().Deployments(a.namespace).Update(ctx, patched, metav1.UpdateOptions{}); err != nil {
+			return status, fmt.Errorf("patch deployment for agent %s: %w", agent.Name, err)
+		}
+		status.Phase = agentdeploymentv1.AgentPhaseProgressing
+	} else if deployment.Status.AvailableReplicas >= agent.Spec.Replicas && deployment.Status.ObservedGeneration >= deployment.Generation {
+		status.Phase = agentdeploymentv1.AgentPhaseRunning
+	}
+
+	if deployment != nil {
+		status.DeployedRevision = deployment.Annotations["deployment.kubernetes.io/revision"]
+	}
+	status.ObservedGeneration = agent.Generation
+	status.Conditions = appendCondition(status.Conditions, agentdeploymentv1.AgentCondition{
+		Type:   "Reconciled",
+		Status: metav1.ConditionTrue,
+		Reason: "SpecApplied",
+	})
+	return status, nil
+}
+
+// Finalize removes the Deployment backing an Agent that is being deleted.
+func (a *K8sAdapter) Finalize(ctx context.Context, agent *agentdeploymentv1.Agent) error {
+	task := model.DeploymentTask{
+		TenantID:     agent.Spec.TenantID,
+		AgentID:      agent.Name,
+		ContainerImg: agent.Spec.ContainerImage,
+		Action:       "DELETE",
+		Replicas:     agent.Spec.Replicas,
+	}
+	if err := a.k8s.DeleteAgent(ctx, task); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete agent %s: %w", agent.Name, err)
+	}
+	return nil
+}
+
+func driftsFromSpec(deployment *appsv1.Deployment, spec agentdeploymentv1.AgentSpec) bool {
+	if len(deployment.Spec.Template.Spec.Containers) == 0 {
+		return true
+	}
+	if deployment.Spec.Template.Spec.Containers[0].Image != spec.ContainerImage {
+		return true
+	}
+	if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != spec.Replicas {
+		return true
+	}
+	return false
+}
+
+func appendCondition(conditions []agentdeploymentv1.AgentCondition, next agentdeploymentv1.AgentCondition) []agentdeploymentv1.AgentCondition {
+	for i, c := range conditions {
+		if c.Type == next.Type {
+			conditions[i] = next
+			return conditions
+		}
+	}
+	return append(conditions, next)
+}
@@ -0,0 +1,171 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+
+	agentdeploymentv1 "github.com/thomas-caarter-aic/agent-deployment-service/pkg/apis/agentdeployment/v1"
+
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+)
+
+// stubAdapter is a container.Adapter test double that records calls and
+// returns a fixed error.
+type stubAdapter struct {
+	deployCalls int
+	deleteCalls int
+	err         error
+}
+
+func (s *stubAdapter) DeployAgent(context.Context, model.DeploymentTask) error {
+	s.deployCalls++
+	return s.err
+}
+
+func (s *stubAdapter) UpdateAgent(context.Context, model.DeploymentTask) error {
+	return s.err
+}
+
+func (s *stubAdapter) DeleteAgent(context.Context, model.DeploymentTask) error {
+	s.deleteCalls++
+	return s.err
+}
+
+func newAgent(name string, generation int64, spec agentdeploymentv1.AgentSpec) *agentdeploymentv1.Agent {
+	return &agentdeploymentv1.Agent{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: generation},
+		Spec:       spec,
+	}
+}
+
+func TestK8sAdapter_Reconcile_DeploysWhenDeploymentMissing(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	adapter := &stubAdapter{}
+	r := NewK8sAdapter(cs, adapter, "default")
+
+	agent := newAgent("agent-test", 1, agentdeploymentv1.AgentSpec{ContainerImage: "test-image:v1", Replicas: 2})
+	status, err := r.Reconcile(context.Background(), agent)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if adapter.deployCalls != 1 {
+		t.Fatalf("expected DeployAgent to be called once for a missing Deployment, got %d", adapter.deployCalls)
+	}
+	if status.Phase != agentdeploymentv1.AgentPhaseProgressing {
+		t.Fatalf("expected Progressing after a fresh deploy, got %q", status.Phase)
+	}
+	if status.ObservedGeneration != 1 {
+		t.Fatalf("expected ObservedGeneration to track agent.Generation, got %d", status.ObservedGeneration)
+	}
+}
+
+func TestK8sAdapter_Reconcile_PatchesOnDrift(t *testing.T) {
+	cs := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(1),
+			Template: corev1PodTemplate("old-image:v1"),
+		},
+	})
+	adapter := &stubAdapter{}
+	r := NewK8sAdapter(cs, adapter, "default")
+
+	agent := newAgent("agent-test", 2, agentdeploymentv1.AgentSpec{ContainerImage: "new-image:v2", Replicas: 3})
+	status, err := r.Reconcile(context.Background(), agent)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if adapter.deployCalls != 0 {
+		t.Fatalf("expected an existing Deployment to be patched, not redeployed, got %d deploy calls", adapter.deployCalls)
+	}
+
+	updated, err := cs.AppsV1().Deployments("default").Get(context.Background(), "agent-test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get updated deployment: %v", err)
+	}
+	if *updated.Spec.Replicas != 3 || updated.Spec.Template.Spec.Containers[0].Image != "new-image:v2" {
+		t.Fatalf("expected the live Deployment to be patched to the new spec, got %+v", updated.Spec)
+	}
+	if status.Phase != agentdeploymentv1.AgentPhaseProgressing {
+		t.Fatalf("expected Progressing after a patch, got %q", status.Phase)
+	}
+}
+
+func TestK8sAdapter_Reconcile_RunningWhenConverged(t *testing.T) {
+	cs := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default", Generation: 2},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(2),
+			Template: corev1PodTemplate("test-image:v1"),
+		},
+		Status: appsv1.DeploymentStatus{AvailableReplicas: 2, ObservedGeneration: 2},
+	})
+	adapter := &stubAdapter{}
+	r := NewK8sAdapter(cs, adapter, "default")
+
+	agent := newAgent("agent-test", 2, agentdeploymentv1.AgentSpec{ContainerImage: "test-image:v1", Replicas: 2})
+	status, err := r.Reconcile(context.Background(), agent)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if status.Phase != agentdeploymentv1.AgentPhaseRunning {
+		t.Fatalf("expected Running once the live Deployment matches spec and has caught up, got %q", status.Phase)
+	}
+}
+
+func TestK8sAdapter_Finalize_DeletesAndToleratesAlreadyGone(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	adapter := &stubAdapter{err: apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "agent-test")}
+	r := NewK8sAdapter(cs, adapter, "default")
+
+	agent := newAgent("agent-test", 1, agentdeploymentv1.AgentSpec{ContainerImage: "test-image:v1"})
+	if err := r.Finalize(context.Background(), agent); err != nil {
+		t.Fatalf("Finalize: %v", err)
+	}
+	if adapter.deleteCalls != 1 {
+		t.Fatalf("expected DeleteAgent to be called once, got %d", adapter.deleteCalls)
+	}
+}
+
+func TestK8sAdapter_Finalize_PropagatesNonNotFoundError(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	adapter := &stubAdapter{err: errors.New("connection refused")}
+	r := NewK8sAdapter(cs, adapter, "default")
+
+	agent := newAgent("agent-test", 1, agentdeploymentv1.AgentSpec{})
+	if err := r.Finalize(context.Background(), agent); err == nil {
+		t.Fatal("expected a non-NotFound delete error to be surfaced")
+	}
+}
+
+func TestAppendCondition_ReplacesSameTypeInPlace(t *testing.T) {
+	conditions := []agentdeploymentv1.AgentCondition{{Type: "Reconciled", Reason: "First"}}
+
+	conditions = appendCondition(conditions, agentdeploymentv1.AgentCondition{Type: "Reconciled", Reason: "Second"})
+	if len(conditions) != 1 || conditions[0].Reason != "Second" {
+		t.Fatalf("expected the existing condition of the same Type to be replaced, got %+v", conditions)
+	}
+
+	conditions = appendCondition(conditions, agentdeploymentv1.AgentCondition{Type: "Other", Reason: "New"})
+	if len(conditions) != 2 {
+		t.Fatalf("expected a condition of a new Type to be appended, got %+v", conditions)
+	}
+}
+
+func corev1PodTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "agent", Image: image}},
+		},
+	}
+}
+
+func int32Ptr(i int32) *int32 { return &i }
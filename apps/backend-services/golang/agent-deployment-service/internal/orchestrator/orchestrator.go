@@ -2,30 +2,147 @@ package orchestrator
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
 
 	"github.com/thomas-caarter-aic/agent-deployment-service/internal/adapters/container"
 	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/statuscheck"
 )
 
-// Orchestrator manages concurrent deployment operations
+// DefaultMaxRetries bounds retries for tasks that don't set
+// model.DeploymentTask.MaxRetries.
+const DefaultMaxRetries = 5
+
+// Result reports the terminal outcome of a task: after it has been applied
+// and, for tasks with WaitForReady set, after readiness has been observed.
+type Result struct {
+	Task      model.DeploymentTask
+	Readiness statuscheck.Readiness
+	Retries   int
+	Err       error
+}
+
+// submission is the bookkeeping the Orchestrator keeps for a task between
+// SubmitTaskCtx and its terminal Result, keyed by a generated ID since
+// workqueue items must be comparable and DeploymentTask holds maps/slices.
+type submission struct {
+	task    model.DeploymentTask
+	results chan Result
+}
+
+// tenantBucket is one tenant's slice of the schedule: three priority-ordered
+// rate-limiting queues. Within a tenant's turn, Critical always drains
+// before Normal, and Normal before Low.
+type tenantBucket struct {
+	critical workqueue.RateLimitingInterface
+	normal   workqueue.RateLimitingInterface
+	low      workqueue.RateLimitingInterface
+}
+
+func newTenantBucket(tenantID string) *tenantBucket {
+	// DefaultControllerRateLimiter combines per-item exponential backoff
+	// with an overall token-bucket (leaky-bucket) rate limit, the same
+	// limiter the operator Controller uses for its reconcile queue.
+	return &tenantBucket{
+		critical: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), tenantID+"-critical"),
+		normal:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), tenantID+"-normal"),
+		low:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), tenantID+"-low"),
+	}
+}
+
+func (b *tenantBucket) queueFor(p model.Priority) workqueue.RateLimitingInterface {
+	switch p {
+	case model.PriorityCritical:
+		return b.critical
+	case model.PriorityLow:
+		return b.low
+	default:
+		return b.normal
+	}
+}
+
+// ordered returns this tenant's queues from highest to lowest priority.
+func (b *tenantBucket) ordered() []workqueue.RateLimitingInterface {
+	return []workqueue.RateLimitingInterface{b.critical, b.normal, b.low}
+}
+
+// shutDownWithDrain shuts down all three of this tenant's queues, blocking
+// until every item already in them has been Get() and Done() by a worker.
+// Unlike ShutDown, this never abandons work that was enqueued before
+// shutdown was requested.
+func (b *tenantBucket) shutDownWithDrain() {
+	var wg sync.WaitGroup
+	for _, q := range b.ordered() {
+		wg.Add(1)
+		go func(q workqueue.RateLimitingInterface) {
+			defer wg.Done()
+			q.ShutDownWithDrain()
+		}(q)
+	}
+	wg.Wait()
+}
+
+// Orchestrator manages concurrent deployment operations. Tasks are
+// scheduled round-robin across tenants so one noisy TenantID can't starve
+// the others, priority-ordered within each tenant's turn, and retried with
+// exponential backoff up to a per-task MaxRetries.
 type Orchestrator struct {
-	taskQueue chan model.DeploymentTask
-	wg        sync.WaitGroup
-	k8s       container.Adapter
+	wg sync.WaitGroup
+
+	schedMu     sync.Mutex
+	tenantOrder []string
+	buckets     map[string]*tenantBucket
+	rrIndex     int
+
+	subMu      sync.Mutex
+	submission map[string]*submission
+	nextID     uint64
+
+	// lastGood remembers, per AgentID, the last task that was applied and
+	// (when it waited for readiness) confirmed ready. rollback uses it to
+	// restore an UPDATE that never becomes ready back to that prior spec
+	// instead of deleting the agent outright.
+	lastGoodMu sync.Mutex
+	lastGood   map[string]model.DeploymentTask
+
+	registry  *container.AdapterRegistry
+	helm      container.Adapter
+	waiter    statuscheck.Waiter
+	namespace string
 	ctx       context.Context
 	cancel    context.CancelFunc
+
+	// shuttingDown is set at the start of Shutdown, before its queues are
+	// drained, so SubmitTaskCtx can reject new work instead of handing the
+	// caller a Result channel that a drained/shut-down queue's Add would
+	// silently no-op forever.
+	shuttingDown atomic.Bool
 }
 
-// NewOrchestrator initializes orchestrator with Kubernetes adapter
-func NewOrchestrator(k8sAdapter container.Adapter, workers int) *Orchestrator {
+// NewOrchestrator initializes orchestrator with a registry of container
+// backends for CREATE/UPDATE/DELETE tasks (selected per-task by
+// model.DeploymentTask.Runtime) and a Helm adapter for chart actions.
+// helmAdapter may be nil if the deployment never submits chart tasks, and
+// waiter may be nil if no task ever sets WaitForReady.
+func NewOrchestrator(registry *container.AdapterRegistry, helmAdapter container.Adapter, waiter statuscheck.Waiter, namespace string, workers int) *Orchestrator {
 	ctx, cancel := context.WithCancel(context.Background())
 	o := &Orchestrator{
-		taskQueue: make(chan model.DeploymentTask, 100),
-		k8s:       k8sAdapter,
-		ctx:       ctx,
-		cancel:    cancel,
+		buckets:    make(map[string]*tenantBucket),
+		submission: make(map[string]*submission),
+		lastGood:   make(map[string]model.DeploymentTask),
+		registry:   registry,
+		helm:       helmAdapter,
+		waiter:     waiter,
+		namespace:  namespace,
+		ctx:        ctx,
+		cancel:     cancel,
 	}
 	o.startWorkers(workers)
 	return o
@@ -34,46 +151,328 @@ func NewOrchestrator(k8sAdapter container.Adapter, workers int) *Orchestrator {
 func (o *Orchestrator) startWorkers(count int) {
 	for i := 0; i < count; i++ {
 		o.wg.Add(1)
-		go func(workerID int) {
-			defer o.wg.Done()
-			log.Printf("Worker %d started", workerID)
-			for {
-				select {
-				case <-o.ctx.Done():
-					log.Printf("Worker %d stopping", workerID)
-					return
-				case task := <-o.taskQueue:
-					log.Printf("Worker %d processing task: %+v", workerID, task)
-					if err := o.handleTask(task); err != nil {
-						log.Printf("Worker %d error: %v", workerID, err)
-					}
-				}
+		go o.worker(i)
+	}
+}
+
+func (o *Orchestrator) worker(id int) {
+	defer o.wg.Done()
+	log.Printf("Worker %d started", id)
+	for {
+		queue, ok := o.nextReady()
+		if !ok {
+			select {
+			case <-o.ctx.Done():
+				log.Printf("Worker %d stopping", id)
+				return
+			case <-time.After(20 * time.Millisecond):
 			}
-		}(i)
+			continue
+		}
+
+		item, shutdown := queue.Get()
+		if shutdown {
+			continue
+		}
+
+		o.process(queue, item.(string))
+	}
+}
+
+// nextReady round-robins the tenant buckets, returning the first non-empty
+// queue it finds (highest priority first within a tenant). The Len() check
+// is a best-effort hint, not a reservation: another worker may drain the
+// queue before this one calls Get(), which just blocks until more work
+// arrives or ShutDown is called.
+func (o *Orchestrator) nextReady() (workqueue.RateLimitingInterface, bool) {
+	o.schedMu.Lock()
+	defer o.schedMu.Unlock()
+
+	n := len(o.tenantOrder)
+	for i := 0; i < n; i++ {
+		idx := (o.rrIndex + i) % n
+		bucket := o.buckets[o.tenantOrder[idx]]
+		for _, q := range bucket.ordered() {
+			if q.Len() > 0 {
+				o.rrIndex = (idx + 1) % n
+				return q, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (o *Orchestrator) bucketFor(tenantID string) *tenantBucket {
+	o.schedMu.Lock()
+	defer o.schedMu.Unlock()
+
+	bucket, ok := o.buckets[tenantID]
+	if !ok {
+		bucket = newTenantBucket(tenantID)
+		o.buckets[tenantID] = bucket
+		o.tenantOrder = append(o.tenantOrder, tenantID)
+	}
+	return bucket
+}
+
+// process applies one task, retrying it with the queue's backoff up to its
+// MaxRetries, and publishes a terminal Result once it either succeeds,
+// reaches readiness, or exhausts its retries.
+func (o *Orchestrator) process(queue workqueue.RateLimitingInterface, id string) {
+	defer queue.Done(id)
+
+	sub := o.lookupSubmission(id)
+	if sub == nil {
+		queue.Forget(id)
+		return
+	}
+	task := sub.task
+	previous, hadPrevious := o.priorGood(task.AgentID)
+
+	err := o.handleTask(task)
+	if err == nil && task.WaitForReady && o.waiter != nil && !isChartAction(task.Action) && task.Action != "DELETE" {
+		readiness, waitErr := o.waitReady(task)
+		if waitErr != nil {
+			o.rollback(task, previous, hadPrevious)
+		}
+		err = waitErr
+		retries := queue.NumRequeues(id)
+		if waitErr == nil {
+			o.recordSuccess(task)
+			queue.Forget(id)
+			o.finish(id, Result{Task: task, Readiness: readiness, Retries: retries})
+			return
+		}
+	}
+
+	if err != nil {
+		maxRetries := task.MaxRetries
+		if maxRetries <= 0 {
+			maxRetries = DefaultMaxRetries
+		}
+		if queue.NumRequeues(id) < maxRetries {
+			log.Printf("Task for agent %s failed, retrying: %v", task.AgentID, err)
+			queue.AddRateLimited(id)
+			return
+		}
+
+		retries := queue.NumRequeues(id)
+		queue.Forget(id)
+		o.finish(id, Result{Task: task, Retries: retries, Err: fmt.Errorf("giving up after %d retries: %w", retries, err)})
+		return
+	}
+
+	o.recordSuccess(task)
+	retries := queue.NumRequeues(id)
+	queue.Forget(id)
+	o.finish(id, Result{Task: task, Retries: retries})
+}
+
+// priorGood returns the last task applied for agentID that was confirmed
+// good, so a failed UPDATE's rollback has something to restore to.
+func (o *Orchestrator) priorGood(agentID string) (model.DeploymentTask, bool) {
+	o.lastGoodMu.Lock()
+	defer o.lastGoodMu.Unlock()
+	task, ok := o.lastGood[agentID]
+	return task, ok
+}
+
+// recordSuccess remembers task as agentID's last-known-good spec, or
+// forgets it entirely once the agent has been deleted.
+func (o *Orchestrator) recordSuccess(task model.DeploymentTask) {
+	o.lastGoodMu.Lock()
+	defer o.lastGoodMu.Unlock()
+	if task.Action == "DELETE" {
+		delete(o.lastGood, task.AgentID)
+		return
+	}
+	o.lastGood[task.AgentID] = task
+}
+
+// statuscheckKind maps the runtime a task was deployed under to the kind of
+// object waitReady should poll, since the waiter only knows how to read
+// Kubernetes objects: a bare Pod runtime never creates a Deployment, and
+// polling one always fails with NotFound, exhausts the timeout, and triggers
+// rollback against a perfectly healthy Pod.
+func statuscheckKind(runtime model.Runtime) (statuscheck.Kind, error) {
+	switch runtime {
+	case model.RuntimeKubernetesDeployment, "":
+		return statuscheck.KindDeployment, nil
+	case model.RuntimeKubernetesPod:
+		return statuscheck.KindPod, nil
+	default:
+		return "", fmt.Errorf("runtime %q has no statuscheck.Kind to wait on", runtime)
+	}
+}
+
+func (o *Orchestrator) waitReady(task model.DeploymentTask) (statuscheck.Readiness, error) {
+	kind, err := statuscheckKind(task.Runtime)
+	if err != nil {
+		return statuscheck.Readiness{}, err
+	}
+
+	timeout := task.Timeout
+	if timeout <= 0 {
+		timeout = 2 * time.Minute
+	}
+	return o.waiter.WaitReady(o.ctx, statuscheck.Target{
+		Kind:            kind,
+		Namespace:       o.namespace,
+		Name:            task.AgentID,
+		DesiredReplicas: task.Replicas,
+	}, timeout)
+}
+
+// rollback undoes a task whose readiness wait failed. A CREATE that never
+// becomes ready has nothing running to preserve, so it's deleted outright.
+// An UPDATE that never becomes ready is reverted to the last-known-good
+// spec instead: the prior revision was healthy, and deleting it would turn
+// a failed update into a full outage instead of a no-op.
+func (o *Orchestrator) rollback(task model.DeploymentTask, previous model.DeploymentTask, hadPrevious bool) {
+	adapter, err := o.registry.For(task.Runtime)
+	if err != nil {
+		log.Printf("Rollback of agent %s failed: %v", task.AgentID, err)
+		return
+	}
+
+	if task.Action != "UPDATE" {
+		log.Printf("Agent %s never became ready, rolling back by deleting it", task.AgentID)
+		if err := adapter.DeleteAgent(o.ctx, task); err != nil {
+			log.Printf("Rollback of agent %s failed: %v", task.AgentID, err)
+		}
+		return
+	}
+
+	if !hadPrevious {
+		log.Printf("Agent %s update never became ready; no prior known-good spec to restore, leaving it as-is", task.AgentID)
+		return
+	}
+
+	log.Printf("Agent %s update never became ready, restoring last-known-good spec", task.AgentID)
+	if err := adapter.UpdateAgent(o.ctx, previous); err != nil {
+		log.Printf("Rollback of agent %s to prior spec failed: %v", task.AgentID, err)
 	}
 }
 
 func (o *Orchestrator) handleTask(task model.DeploymentTask) error {
+	if isChartAction(task.Action) {
+		return o.handleChartTask(task)
+	}
+
+	adapter, err := o.registry.For(task.Runtime)
+	if err != nil {
+		return err
+	}
+
 	switch task.Action {
 	case "CREATE":
-		return o.k8s.DeployAgent(o.ctx, task)
+		return adapter.DeployAgent(o.ctx, task)
 	case "UPDATE":
-		return o.k8s.UpdateAgent(o.ctx, task)
+		return adapter.UpdateAgent(o.ctx, task)
 	case "DELETE":
-		return o.k8s.DeleteAgent(o.ctx, task)
+		return adapter.DeleteAgent(o.ctx, task)
+	default:
+		return nil
+	}
+}
+
+func (o *Orchestrator) handleChartTask(task model.DeploymentTask) error {
+	switch task.Action {
+	case model.ActionInstallChart:
+		return o.helm.DeployAgent(o.ctx, task)
+	case model.ActionUpgradeChart:
+		return o.helm.UpdateAgent(o.ctx, task)
+	case model.ActionUninstallChart:
+		return o.helm.DeleteAgent(o.ctx, task)
 	default:
 		return nil
 	}
 }
 
-// SubmitTask enqueues a deployment task
+func isChartAction(action string) bool {
+	return strings.HasSuffix(action, "_CHART")
+}
+
+func (o *Orchestrator) lookupSubmission(id string) *submission {
+	o.subMu.Lock()
+	defer o.subMu.Unlock()
+	return o.submission[id]
+}
+
+func (o *Orchestrator) finish(id string, result Result) {
+	o.subMu.Lock()
+	sub, ok := o.submission[id]
+	delete(o.submission, id)
+	o.subMu.Unlock()
+
+	if !ok {
+		return
+	}
+	sub.results <- result
+	close(sub.results)
+}
+
+// SubmitTask enqueues a deployment task without waiting for its outcome,
+// preserving the original fire-and-forget API.
 func (o *Orchestrator) SubmitTask(task model.DeploymentTask) {
-	o.taskQueue <- task
+	if _, err := o.SubmitTaskCtx(o.ctx, task); err != nil {
+		log.Printf("SubmitTask for agent %s: %v", task.AgentID, err)
+	}
+}
+
+// SubmitTaskCtx enqueues task under its TenantID's fair-scheduling bucket
+// and priority, and returns a Result future: a channel that receives
+// exactly one Result, including the retry count, once the task finishes or
+// exhausts its retries.
+func (o *Orchestrator) SubmitTaskCtx(ctx context.Context, task model.DeploymentTask) (<-chan Result, error) {
+	if task.TenantID == "" {
+		return nil, fmt.Errorf("task for agent %s has no TenantID", task.AgentID)
+	}
+	if o.shuttingDown.Load() {
+		return nil, fmt.Errorf("task for agent %s rejected: orchestrator is shutting down", task.AgentID)
+	}
+
+	id := o.newSubmissionID(task)
+	results := make(chan Result, 1)
+
+	o.subMu.Lock()
+	o.submission[id] = &submission{task: task, results: results}
+	o.subMu.Unlock()
+
+	o.bucketFor(task.TenantID).queueFor(task.Priority).Add(id)
+	return results, nil
 }
 
-// Shutdown gracefully stops orchestrator
+func (o *Orchestrator) newSubmissionID(task model.DeploymentTask) string {
+	return fmt.Sprintf("%s/%s/%d", task.TenantID, task.AgentID, atomic.AddUint64(&o.nextID, 1))
+}
+
+// Shutdown gracefully stops orchestrator: every tenant queue is drained of
+// the work already sitting in it, and only once that's confirmed does it
+// cancel the shared context and let workers exit. Cancelling first would
+// let a worker give up on an already-enqueued task the instant it woke on
+// ctx.Done(), leaving that task's caller waiting on a Result that never
+// arrives.
 func (o *Orchestrator) Shutdown() {
+	o.shuttingDown.Store(true)
+
+	o.schedMu.Lock()
+	buckets := make([]*tenantBucket, 0, len(o.tenantOrder))
+	for _, tenant := range o.tenantOrder {
+		buckets = append(buckets, o.buckets[tenant])
+	}
+	o.schedMu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, bucket := range buckets {
+		wg.Add(1)
+		go func(b *tenantBucket) {
+			defer wg.Done()
+			b.shutDownWithDrain()
+		}(bucket)
+	}
+	wg.Wait()
+
 	o.cancel()
 	o.wg.Wait()
-	close(o.taskQueue)
 }
@@ -0,0 +1,160 @@
+// Package statuscheck polls the Kubernetes objects an Adapter creates until
+// they report Ready, so the orchestrator can block a rollout on readiness
+// instead of treating a successful Create/Update call as done.
+package statuscheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Kind identifies which readiness converter to poll a Target with.
+type Kind string
+
+const (
+	KindDeployment Kind = "Deployment"
+	KindPod        Kind = "Pod"
+	KindService    Kind = "Service"
+	KindJob        Kind = "Job"
+)
+
+// Target identifies the object to poll for readiness. DesiredReplicas is
+// only consulted for KindDeployment.
+type Target struct {
+	Kind            Kind
+	Namespace       string
+	Name            string
+	DesiredReplicas int32
+}
+
+// Readiness is the outcome of a single readiness check.
+type Readiness struct {
+	Ready  bool
+	Reason string
+}
+
+// Waiter polls a Target until it is Ready or the timeout elapses.
+type Waiter interface {
+	WaitReady(ctx context.Context, target Target, timeout time.Duration) (Readiness, error)
+}
+
+// readinessFunc checks a Target once, returning whether it's ready yet.
+type readinessFunc func(ctx context.Context, kubeClient kubernetes.Interface, target Target) (Readiness, error)
+
+// converters maps each supported Kind to the function that checks it.
+var converters = map[Kind]readinessFunc{
+	KindDeployment: deploymentReady,
+	KindPod:        podReady,
+	KindService:    serviceReady,
+	KindJob:        jobReady,
+}
+
+// K8sWaiter is the Waiter backed by a live Kubernetes API server.
+type K8sWaiter struct {
+	kubeClient   kubernetes.Interface
+	pollInterval time.Duration
+}
+
+// NewK8sWaiter builds a Waiter that polls the cluster through kubeClient
+// every pollInterval.
+func NewK8sWaiter(kubeClient kubernetes.Interface, pollInterval time.Duration) *K8sWaiter {
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second
+	}
+	return &K8sWaiter{kubeClient: kubeClient, pollInterval: pollInterval}
+}
+
+// WaitReady polls target until its converter reports Ready, the timeout
+// elapses, ctx is done, or the converter returns an error other than
+// NotFound (a failed Job is reported as such an error).
+func (w *K8sWaiter) WaitReady(ctx context.Context, target Target, timeout time.Duration) (Readiness, error) {
+	convert, ok := converters[target.Kind]
+	if !ok {
+		return Readiness{}, fmt.Errorf("no readiness converter registered for kind %q", target.Kind)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last Readiness
+	err := wait.PollUntilContextCancel(waitCtx, w.pollInterval, true, func(ctx context.Context) (bool, error) {
+		r, err := convert(ctx, w.kubeClient, target)
+		if err != nil && apierrors.IsNotFound(err) {
+			last = Readiness{Ready: false, Reason: "not found yet"}
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		last = r
+		return r.Ready, nil
+	})
+	if err != nil {
+		return last, fmt.Errorf("%s/%s (%s) not ready after %s: %w", target.Namespace, target.Name, target.Kind, timeout, err)
+	}
+	return last, nil
+}
+
+func deploymentReady(ctx context.Context, kubeClient kubernetes.Interface, target Target) (Readiness, error) {
+	d, err := kubeClient.AppsV1().Deployments(target.Namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return Readiness{}, err
+	}
+	ready := d.Status.AvailableReplicas >= target.DesiredReplicas && d.Status.ObservedGeneration >= d.Generation
+	return Readiness{
+		Ready:  ready,
+		Reason: fmt.Sprintf("availableReplicas=%d/%d observedGeneration=%d/%d", d.Status.AvailableReplicas, target.DesiredReplicas, d.Status.ObservedGeneration, d.Generation),
+	}, nil
+}
+
+func podReady(ctx context.Context, kubeClient kubernetes.Interface, target Target) (Readiness, error) {
+	p, err := kubeClient.CoreV1().Pods(target.Namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return Readiness{}, err
+	}
+	for _, cond := range p.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return Readiness{Ready: cond.Status == corev1.ConditionTrue, Reason: cond.Reason}, nil
+		}
+	}
+	return Readiness{Ready: false, Reason: "PodReady condition not reported yet"}, nil
+}
+
+func serviceReady(ctx context.Context, kubeClient kubernetes.Interface, target Target) (Readiness, error) {
+	endpoints, err := kubeClient.CoreV1().Endpoints(target.Namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return Readiness{}, err
+	}
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return Readiness{Ready: true, Reason: fmt.Sprintf("%d address(es) populated", len(subset.Addresses))}, nil
+		}
+	}
+	return Readiness{Ready: false, Reason: "no endpoint addresses populated yet"}, nil
+}
+
+func jobReady(ctx context.Context, kubeClient kubernetes.Interface, target Target) (Readiness, error) {
+	j, err := kubeClient.BatchV1().Jobs(target.Namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	if err != nil {
+		return Readiness{}, err
+	}
+	if j.Status.Succeeded > 0 {
+		return Readiness{Ready: true, Reason: "job succeeded"}, nil
+	}
+	for _, cond := range j.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			// A failed Job never becomes ready, so report it as an error to
+			// stop polling instead of waiting out the timeout.
+			return Readiness{Ready: false, Reason: "job failed: " + cond.Reason}, fmt.Errorf("job %s/%s failed: %s", target.Namespace, target.Name, cond.Reason)
+		}
+	}
+	return Readiness{Ready: false, Reason: "job still running"}, nil
+}
@@ -0,0 +1,133 @@
+package statuscheck
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestK8sWaiter_WaitReady_Deployment(t *testing.T) {
+	cs := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default", Generation: 1},
+		Status:     appsv1.DeploymentStatus{AvailableReplicas: 2, ObservedGeneration: 1},
+	})
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	readiness, err := waiter.WaitReady(context.Background(), Target{
+		Kind: KindDeployment, Namespace: "default", Name: "agent-test", DesiredReplicas: 2,
+	}, time.Second)
+	if err != nil {
+		t.Fatalf("WaitReady: %v", err)
+	}
+	if !readiness.Ready {
+		t.Fatalf("expected ready, got %+v", readiness)
+	}
+}
+
+func TestK8sWaiter_WaitReady_DeploymentNotEnoughReplicasTimesOut(t *testing.T) {
+	cs := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default", Generation: 1},
+		Status:     appsv1.DeploymentStatus{AvailableReplicas: 1, ObservedGeneration: 1},
+	})
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	_, err := waiter.WaitReady(context.Background(), Target{
+		Kind: KindDeployment, Namespace: "default", Name: "agent-test", DesiredReplicas: 2,
+	}, 50*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected WaitReady to time out when AvailableReplicas never reaches DesiredReplicas")
+	}
+}
+
+func TestK8sWaiter_WaitReady_PodReady(t *testing.T) {
+	cs := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default"},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+		}},
+	})
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	readiness, err := waiter.WaitReady(context.Background(), Target{Kind: KindPod, Namespace: "default", Name: "agent-test"}, time.Second)
+	if err != nil {
+		t.Fatalf("WaitReady: %v", err)
+	}
+	if !readiness.Ready {
+		t.Fatalf("expected ready, got %+v", readiness)
+	}
+}
+
+func TestK8sWaiter_WaitReady_ServiceReadyWhenEndpointsPopulated(t *testing.T) {
+	cs := fake.NewSimpleClientset(&corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default"},
+		Subsets: []corev1.EndpointSubset{
+			{Addresses: []corev1.EndpointAddress{{IP: "10.0.0.5"}}},
+		},
+	})
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	readiness, err := waiter.WaitReady(context.Background(), Target{Kind: KindService, Namespace: "default", Name: "agent-test"}, time.Second)
+	if err != nil {
+		t.Fatalf("WaitReady: %v", err)
+	}
+	if !readiness.Ready {
+		t.Fatalf("expected ready once endpoints are populated, got %+v", readiness)
+	}
+}
+
+func TestK8sWaiter_WaitReady_JobSucceeded(t *testing.T) {
+	cs := fake.NewSimpleClientset(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default"},
+		Status:     batchv1.JobStatus{Succeeded: 1},
+	})
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	readiness, err := waiter.WaitReady(context.Background(), Target{Kind: KindJob, Namespace: "default", Name: "agent-test"}, time.Second)
+	if err != nil {
+		t.Fatalf("WaitReady: %v", err)
+	}
+	if !readiness.Ready {
+		t.Fatalf("expected ready once the job succeeded, got %+v", readiness)
+	}
+}
+
+func TestK8sWaiter_WaitReady_JobFailedStopsWaitingEarly(t *testing.T) {
+	cs := fake.NewSimpleClientset(&batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent-test", Namespace: "default"},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+		}},
+	})
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	_, err := waiter.WaitReady(context.Background(), Target{Kind: KindJob, Namespace: "default", Name: "agent-test"}, time.Second)
+	if err == nil {
+		t.Fatal("expected WaitReady to surface an error for a failed job rather than report ready")
+	}
+}
+
+func TestK8sWaiter_WaitReady_ObjectNotFoundYetIsRetriedNotFatal(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	_, err := waiter.WaitReady(context.Background(), Target{Kind: KindPod, Namespace: "default", Name: "agent-test"}, 50*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a timeout error once the object never appears")
+	}
+}
+
+func TestK8sWaiter_WaitReady_UnknownKindErrors(t *testing.T) {
+	cs := fake.NewSimpleClientset()
+	waiter := NewK8sWaiter(cs, 10*time.Millisecond)
+
+	_, err := waiter.WaitReady(context.Background(), Target{Kind: "Bogus", Namespace: "default", Name: "agent-test"}, time.Second)
+	if err == nil {
+		t.Fatal("expected an error for a Kind with no registered converter")
+	}
+}
@@ -0,0 +1,109 @@
+// Package v1 contains the Agent custom resource definition reconciled by
+// the agent-deployment operator.
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UpdateStrategy controls how a rollout replaces running replicas.
+type UpdateStrategy string
+
+const (
+	// RollingUpdateStrategy replaces Pods incrementally.
+	RollingUpdateStrategy UpdateStrategy = "RollingUpdate"
+	// RecreateStrategy tears down all Pods before creating their
+	// replacements.
+	RecreateStrategy UpdateStrategy = "Recreate"
+)
+
+// AgentPhase is a coarse summary of where an Agent is in its lifecycle.
+type AgentPhase string
+
+const (
+	AgentPhasePending     AgentPhase = "Pending"
+	AgentPhaseProgressing AgentPhase = "Progressing"
+	AgentPhaseRunning     AgentPhase = "Running"
+	AgentPhaseFailed      AgentPhase = "Failed"
+)
+
+// AgentSpec is the desired state of an Agent.
+type AgentSpec struct {
+	// ContainerImage is the image reference deployed for this agent.
+	ContainerImage string `json:"containerImage"`
+	// Replicas is the desired replica count of the backing Deployment.
+	Replicas int32 `json:"replicas"`
+	// TenantID scopes the agent to a tenant for multi-tenant clusters.
+	TenantID string `json:"tenantID"`
+	// UpdateStrategy selects how rollouts replace existing replicas.
+	UpdateStrategy UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// AgentCondition is a single observation of an Agent's state, following the
+// standard Kubernetes condition shape.
+type AgentCondition struct {
+	Type               string                 `json:"type"`
+	Status             metav1.ConditionStatus `json:"status"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+}
+
+// AgentStatus is the observed state of an Agent, written back through the
+// /status subresource.
+type AgentStatus struct {
+	// ObservedGeneration is the spec generation the controller last
+	// reconciled. The controller skips an Agent whose ObservedGeneration
+	// equals metadata.generation once its Phase is Running; otherwise it
+	// reconciles again.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Phase is a coarse summary of the Agent's rollout state.
+	Phase AgentPhase `json:"phase,omitempty"`
+	// Conditions is the detailed history of state transitions.
+	Conditions []AgentCondition `json:"conditions,omitempty"`
+	// DeployedRevision is the identifier of the Deployment revision
+	// currently live for this agent.
+	DeployedRevision string `json:"deployedRevision,omitempty"`
+	// ResourceBinding aggregates the per-member-cluster rollout status for
+	// agents propagated by the container.MultiClusterAdapter. It is nil
+	// for agents deployed to a single cluster.
+	ResourceBinding *ResourceBindingStatus `json:"resourceBinding,omitempty"`
+}
+
+// ClusterStatus is the observed rollout state of an Agent on a single
+// member cluster.
+type ClusterStatus struct {
+	ClusterName       string `json:"clusterName"`
+	Applied           bool   `json:"applied"`
+	AvailableReplicas int32  `json:"availableReplicas"`
+	Message           string `json:"message,omitempty"`
+}
+
+// ResourceBindingStatus is the aggregated view of a propagated Agent across
+// every member cluster its PropagationPolicy targeted.
+type ResourceBindingStatus struct {
+	// Clusters holds one entry per member cluster the Work was placed on.
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Agent is the schema for the agents API, reconciled by the
+// agent-deployment operator.
+type Agent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AgentSpec   `json:"spec,omitempty"`
+	Status AgentStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AgentList is a list of Agent resources.
+type AgentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Agent `json:"items"`
+}
@@ -2,14 +2,26 @@ package tests
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/thomas-caarter-aic/agent-deployment-service/internal/adapters/container"
 	"github.com/thomas-caarter-aic/agent-deployment-service/internal/model"
 	"github.com/thomas-caarter-aic/agent-deployment-service/internal/orchestrator"
+	"github.com/thomas-caarter-aic/agent-deployment-service/internal/statuscheck"
 )
 
+// neverReadyWaiter always reports a task as not ready, so tests can force
+// the Orchestrator's rollback path without waiting out a real timeout.
+type neverReadyWaiter struct{}
+
+func (neverReadyWaiter) WaitReady(ctx context.Context, target statuscheck.Target, timeout time.Duration) (statuscheck.Readiness, error) {
+	return statuscheck.Readiness{Ready: false, Reason: "test never reports ready"}, errors.New("never became ready")
+}
+
 type MockAdapter struct {
 	mock.Mock
 }
@@ -29,19 +41,315 @@ func (m *MockAdapter) DeleteAgent(ctx context.Context, task model.DeploymentTask
 
 func TestOrchestrator_SubmitTask(t *testing.T) {
 	mockAdapter := new(MockAdapter)
-	orchestrator := orchestrator.NewOrchestrator(mockAdapter, 2)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesDeployment, mockAdapter)
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, nil, "default", 2)
+
+	task := model.DeploymentTask{
+		TenantID:     "tenant-test",
+		AgentID:      "agent-test",
+		ContainerImg: "test-image:v1",
+		Action:       "CREATE",
+	}
+
+	mockAdapter.On("DeployAgent", mock.Anything, task).Return(nil).Once()
+
+	orch.SubmitTask(task)
+	orch.Shutdown()
+
+	mockAdapter.AssertExpectations(t)
+}
+
+// TestOrchestrator_SubmitTaskCtx_Fairness submits a burst of tasks from two
+// tenants and checks neither tenant's tasks all finish before the other's
+// first one starts, i.e. the round-robin schedule doesn't starve tenant-b
+// behind a backlog queued for tenant-a.
+func TestOrchestrator_SubmitTaskCtx_Fairness(t *testing.T) {
+	mockAdapter := new(MockAdapter)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesDeployment, mockAdapter)
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, nil, "default", 1)
+	defer orch.Shutdown()
+
+	const perTenant = 5
+	var mu sync.Mutex
+	var order []string
+
+	mockAdapter.On("DeployAgent", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		task := args.Get(1).(model.DeploymentTask)
+		mu.Lock()
+		order = append(order, task.TenantID)
+		mu.Unlock()
+	})
+
+	results := make([]<-chan orchestrator.Result, 0, perTenant*2)
+	for i := 0; i < perTenant; i++ {
+		for _, tenant := range []string{"tenant-a", "tenant-b"} {
+			ch, err := orch.SubmitTaskCtx(context.Background(), model.DeploymentTask{
+				TenantID:     tenant,
+				AgentID:      tenant + "-agent",
+				ContainerImg: "test-image:v1",
+				Action:       "CREATE",
+			})
+			if err != nil {
+				t.Fatalf("SubmitTaskCtx: %v", err)
+			}
+			results = append(results, ch)
+		}
+	}
+
+	for _, ch := range results {
+		select {
+		case <-ch:
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for task result")
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(order) < 4 {
+		t.Fatalf("expected both tenants' tasks to run, got order %v", order)
+	}
+	seenA, seenB := false, false
+	for _, tenant := range order[:2] {
+		if tenant == "tenant-a" {
+			seenA = true
+		}
+		if tenant == "tenant-b" {
+			seenB = true
+		}
+	}
+	if !seenA || !seenB {
+		t.Fatalf("tenant-b starved behind tenant-a's backlog, first two scheduled: %v", order[:2])
+	}
+}
+
+// TestOrchestrator_SubmitTaskCtx_RetriesThenGivesUp checks a task that keeps
+// failing is retried up to MaxRetries and then reported with the retry
+// count baked into the terminal Result.
+func TestOrchestrator_SubmitTaskCtx_RetriesThenGivesUp(t *testing.T) {
+	mockAdapter := new(MockAdapter)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesDeployment, mockAdapter)
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, nil, "default", 1)
+	defer orch.Shutdown()
+
+	task := model.DeploymentTask{
+		TenantID:     "tenant-test",
+		AgentID:      "flaky-agent",
+		ContainerImg: "test-image:v1",
+		Action:       "CREATE",
+		MaxRetries:   2,
+	}
+
+	mockAdapter.On("DeployAgent", mock.Anything, task).Return(assertError).Times(3)
+
+	ch, err := orch.SubmitTaskCtx(context.Background(), task)
+	if err != nil {
+		t.Fatalf("SubmitTaskCtx: %v", err)
+	}
+
+	select {
+	case result := <-ch:
+		if result.Err == nil {
+			t.Fatal("expected a terminal error after exhausting retries")
+		}
+		if result.Retries != task.MaxRetries {
+			t.Fatalf("expected %d retries, got %d", task.MaxRetries, result.Retries)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for task result")
+	}
+
+	mockAdapter.AssertExpectations(t)
+}
+
+// TestOrchestrator_SubmitTaskCtx_RequiresTenantID checks a task with no
+// TenantID is rejected up front instead of being silently dropped into an
+// anonymous bucket.
+func TestOrchestrator_SubmitTaskCtx_RequiresTenantID(t *testing.T) {
+	registry := container.NewAdapterRegistry()
+	orch := orchestrator.NewOrchestrator(registry, nil, nil, "default", 1)
+	defer orch.Shutdown()
+
+	_, err := orch.SubmitTaskCtx(context.Background(), model.DeploymentTask{AgentID: "agent-test", Action: "CREATE"})
+	if err == nil {
+		t.Fatal("expected an error for a task with no TenantID")
+	}
+}
+
+// TestOrchestrator_FailedUpdateRollsBackToPriorSpec checks that a failed
+// readiness wait on an UPDATE restores the last-known-good spec instead of
+// deleting the agent outright — deleting it would turn a failed update of
+// a running agent into a full outage.
+func TestOrchestrator_FailedUpdateRollsBackToPriorSpec(t *testing.T) {
+	mockAdapter := new(MockAdapter)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesDeployment, mockAdapter)
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, neverReadyWaiter{}, "default", 1)
+	defer orch.Shutdown()
+
+	goodTask := model.DeploymentTask{
+		TenantID:     "tenant-test",
+		AgentID:      "agent-test",
+		ContainerImg: "test-image:v1",
+		Action:       "CREATE",
+	}
+	badUpdate := model.DeploymentTask{
+		TenantID:     "tenant-test",
+		AgentID:      "agent-test",
+		ContainerImg: "test-image:v2-broken",
+		Action:       "UPDATE",
+		WaitForReady: true,
+		MaxRetries:   1,
+	}
+
+	mockAdapter.On("DeployAgent", mock.Anything, goodTask).Return(nil).Once()
+	mockAdapter.On("UpdateAgent", mock.Anything, badUpdate).Return(nil).Times(2)
+	mockAdapter.On("UpdateAgent", mock.Anything, goodTask).Return(nil).Times(2)
+
+	okCh, err := orch.SubmitTaskCtx(context.Background(), goodTask)
+	if err != nil {
+		t.Fatalf("SubmitTaskCtx: %v", err)
+	}
+	<-okCh
+
+	failCh, err := orch.SubmitTaskCtx(context.Background(), badUpdate)
+	if err != nil {
+		t.Fatalf("SubmitTaskCtx: %v", err)
+	}
+
+	select {
+	case result := <-failCh:
+		if result.Err == nil {
+			t.Fatal("expected the update to report an error after never becoming ready")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for task result")
+	}
+
+	mockAdapter.AssertExpectations(t)
+	mockAdapter.AssertNotCalled(t, "DeleteAgent", mock.Anything, mock.Anything)
+}
+
+// recordingWaiter saves the Kind it was last asked to wait on and reports
+// the task ready immediately, so a test can assert the orchestrator picked
+// the right statuscheck.Kind for the runtime without waiting out a timeout.
+type recordingWaiter struct {
+	lastKind statuscheck.Kind
+}
+
+func (r *recordingWaiter) WaitReady(ctx context.Context, target statuscheck.Target, timeout time.Duration) (statuscheck.Readiness, error) {
+	r.lastKind = target.Kind
+	return statuscheck.Readiness{Ready: true, Reason: "test always reports ready"}, nil
+}
+
+// TestOrchestrator_WaitReady_MapsKindFromRuntime checks waitReady polls the
+// Kind that matches the object the task's Runtime actually deploys, instead
+// of always polling a Deployment: a RuntimeKubernetesPod task never creates
+// one, so polling KindDeployment would always time out and trigger a
+// needless rollback of a perfectly healthy Pod.
+func TestOrchestrator_WaitReady_MapsKindFromRuntime(t *testing.T) {
+	mockAdapter := new(MockAdapter)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesPod, mockAdapter)
+	waiter := &recordingWaiter{}
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, waiter, "default", 1)
+	defer orch.Shutdown()
 
 	task := model.DeploymentTask{
 		TenantID:     "tenant-test",
 		AgentID:      "agent-test",
 		ContainerImg: "test-image:v1",
 		Action:       "CREATE",
+		Runtime:      model.RuntimeKubernetesPod,
+		WaitForReady: true,
+	}
+	mockAdapter.On("DeployAgent", mock.Anything, task).Return(nil).Once()
+
+	ch, err := orch.SubmitTaskCtx(context.Background(), task)
+	if err != nil {
+		t.Fatalf("SubmitTaskCtx: %v", err)
+	}
+
+	select {
+	case result := <-ch:
+		if result.Err != nil {
+			t.Fatalf("unexpected error: %v", result.Err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for task result")
 	}
 
+	if waiter.lastKind != statuscheck.KindPod {
+		t.Fatalf("expected waitReady to poll KindPod for a RuntimeKubernetesPod task, got %q", waiter.lastKind)
+	}
+}
+
+// TestOrchestrator_SubmitThenShutdown_DrainsInFlightTask is a regression
+// test for a race where Shutdown cancelled the shared context before the
+// tenant queues were drained: a task submitted immediately before Shutdown
+// could be abandoned by a worker waking on ctx.Done(), leaving its Result
+// channel never written to. Run with -race -count=20 to catch regressions.
+func TestOrchestrator_SubmitThenShutdown_DrainsInFlightTask(t *testing.T) {
+	mockAdapter := new(MockAdapter)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesDeployment, mockAdapter)
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, nil, "default", 2)
+
+	task := model.DeploymentTask{
+		TenantID:     "tenant-test",
+		AgentID:      "agent-test",
+		ContainerImg: "test-image:v1",
+		Action:       "CREATE",
+	}
 	mockAdapter.On("DeployAgent", mock.Anything, task).Return(nil).Once()
 
-	orchestrator.SubmitTask(task)
-	orchestrator.Shutdown()
+	ch, err := orch.SubmitTaskCtx(context.Background(), task)
+	if err != nil {
+		t.Fatalf("SubmitTaskCtx: %v", err)
+	}
+	orch.Shutdown()
+
+	select {
+	case result := <-ch:
+		if result.Err != nil {
+			t.Fatalf("unexpected error: %v", result.Err)
+		}
+	default:
+		t.Fatal("Shutdown returned without draining the already-enqueued task")
+	}
 
 	mockAdapter.AssertExpectations(t)
 }
+
+// TestOrchestrator_SubmitTaskCtx_RejectsAfterShutdown is a regression test
+// for a hang where a submission made once Shutdown had already drained a
+// tenant's queues sat in o.submission forever: queue.Add silently no-ops
+// after ShutDownWithDrain, so the caller's Result channel was never written
+// to or closed. SubmitTaskCtx must reject the submission instead.
+func TestOrchestrator_SubmitTaskCtx_RejectsAfterShutdown(t *testing.T) {
+	mockAdapter := new(MockAdapter)
+	registry := container.NewAdapterRegistry()
+	registry.Register(model.RuntimeKubernetesDeployment, mockAdapter)
+	orch := orchestrator.NewOrchestrator(registry, mockAdapter, nil, "default", 1)
+
+	orch.Shutdown()
+
+	_, err := orch.SubmitTaskCtx(context.Background(), model.DeploymentTask{
+		TenantID:     "tenant-test",
+		AgentID:      "agent-test",
+		ContainerImg: "test-image:v1",
+		Action:       "CREATE",
+	})
+	if err == nil {
+		t.Fatal("expected SubmitTaskCtx to reject a task submitted after Shutdown")
+	}
+}
+
+var assertError = &orchestratorTestError{msg: "deploy failed"}
+
+type orchestratorTestError struct{ msg string }
+
+func (e *orchestratorTestError) Error() string { return e.msg }